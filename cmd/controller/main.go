@@ -20,6 +20,7 @@ import (
 	gitlab "knative.dev/eventing-gitlab/pkg/reconciler/source"
 
 	"knative.dev/pkg/injection/sharedmain"
+	"knative.dev/pkg/signals"
 )
 
 const (
@@ -27,5 +28,7 @@ const (
 )
 
 func main() {
-	sharedmain.Main(component, gitlab.NewController)
+	ctx := gitlab.WithSecretInformer(signals.NewContext())
+
+	sharedmain.MainWithContext(ctx, component, gitlab.NewController, gitlab.NewSystemSourceController)
 }
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"knative.dev/eventing/pkg/adapter/v2"
+
+	gitlabadapter "knative.dev/eventing-gitlab/pkg/adapter"
+	"knative.dev/eventing-gitlab/pkg/secret"
+	filteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/signals"
+)
+
+func main() {
+	ctx := signals.NewContext()
+	ctx = adapter.WithInjectorEnabled(ctx)
+	// the adapter's own server listens on the default port of health probes
+	ctx = adapter.WithHealthProbesDisabled(ctx)
+	// only the Secrets referenced by event sources are cached
+	ctx = filteredfactory.WithSelectors(ctx, secret.WatchLabelSelector)
+
+	adapter.MainWithContext(ctx, "gitlabsource-shared", gitlabadapter.NewSharedEnvConfig, gitlabadapter.NewSharedAdapter)
+}
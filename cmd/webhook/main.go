@@ -22,7 +22,9 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	bindingv1alpha1 "knative.dev/eventing-gitlab/pkg/apis/bindings/v1alpha1"
+	bindingv1beta1 "knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
 	sourcev1alpha1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	sourcev1beta1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
 	"knative.dev/eventing-gitlab/pkg/reconciler/binding"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -33,13 +35,17 @@ import (
 	"knative.dev/pkg/webhook/certificates"
 	"knative.dev/pkg/webhook/psbinding"
 	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 )
 
 var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
-	sourcev1alpha1.SchemeGroupVersion.WithKind("GitLabSource"):   &sourcev1alpha1.GitLabSource{},
-	bindingv1alpha1.SchemeGroupVersion.WithKind("GitLabBinding"): &bindingv1alpha1.GitLabBinding{},
+	sourcev1alpha1.SchemeGroupVersion.WithKind("GitLabSource"):       &sourcev1alpha1.GitLabSource{},
+	sourcev1alpha1.SchemeGroupVersion.WithKind("GitLabSystemSource"): &sourcev1alpha1.GitLabSystemSource{},
+	sourcev1beta1.SchemeGroupVersion.WithKind("GitLabSource"):        &sourcev1beta1.GitLabSource{},
+	bindingv1alpha1.SchemeGroupVersion.WithKind("GitLabBinding"):     &bindingv1alpha1.GitLabBinding{},
+	bindingv1beta1.SchemeGroupVersion.WithKind("GitLabBinding"):      &bindingv1beta1.GitLabBinding{},
 }
 
 var callbacks = map[schema.GroupVersionKind]validation.Callback{}
@@ -95,6 +101,49 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 	)
 }
 
+func NewConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	var (
+		sourcesv1alpha1_  = sourcev1alpha1.SchemeGroupVersion.Version
+		sourcesv1beta1_   = sourcev1beta1.SchemeGroupVersion.Version
+		bindingsv1alpha1_ = bindingv1alpha1.SchemeGroupVersion.Version
+		bindingsv1beta1_  = bindingv1beta1.SchemeGroupVersion.Version
+	)
+
+	return conversion.NewConversionController(ctx,
+
+		// The path on which to serve the webhook.
+		"/resource-conversion",
+
+		// Specify the types of custom resource definitions that should be converted.
+		// The v1alpha1 hubs convert to and from v1beta1, and carry the
+		// fields which only exist in v1beta1 in an annotation, so that
+		// these fields survive updates through the v1alpha1 API.
+		map[schema.GroupKind]conversion.GroupKindConversion{
+			sourcev1beta1.Kind("GitLabSource"): {
+				DefinitionName: sourcev1beta1.Resource("gitlabsources").String(),
+				HubVersion:     sourcesv1alpha1_,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					sourcesv1alpha1_: &sourcev1alpha1.GitLabSource{},
+					sourcesv1beta1_:  &sourcev1beta1.GitLabSource{},
+				},
+			},
+			bindingv1beta1.Kind("GitLabBinding"): {
+				DefinitionName: bindingv1beta1.Resource("gitlabbindings").String(),
+				HubVersion:     bindingsv1alpha1_,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					bindingsv1alpha1_: &bindingv1alpha1.GitLabBinding{},
+					bindingsv1beta1_:  &bindingv1beta1.GitLabBinding{},
+				},
+			},
+		},
+
+		// A function that infuses the context passed to ConvertTo/ConvertFrom/SetDefaults with custom metadata.
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+	)
+}
+
 func NewGitLabBindingWebhook(opts ...psbinding.ReconcilerOption) injection.ControllerConstructor {
 	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 		return psbinding.NewAdmissionController(ctx,
@@ -135,6 +184,7 @@ func main() {
 		certificates.NewController,
 		NewDefaultingAdmissionController,
 		NewValidationAdmissionController,
+		NewConversionController,
 
 		binding.NewController, NewGitLabBindingWebhook(glbSelector),
 	)
@@ -25,6 +25,7 @@ export GO111MODULE=on
 declare -A COMPONENTS
 COMPONENTS=(
   ["gitlab.yaml"]="config"
+  ["gitlab-post-install.yaml"]="config/post-install"
 )
 readonly COMPONENTS
 
@@ -22,4 +22,7 @@ package tools
 import (
 	_ "knative.dev/hack"
 	_ "knative.dev/pkg/hack"
+
+	// Migrates the storage version of custom resources after upgrades.
+	_ "knative.dev/pkg/apiextensions/storageversion/cmd/migrate"
 )
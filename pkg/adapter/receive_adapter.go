@@ -18,6 +18,7 @@ package adapter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"go.uber.org/zap"
 
 	sourcesv1alpha1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
@@ -35,6 +37,8 @@ import (
 const (
 	glHeaderEvent       = "X-Gitlab-Event"
 	glHeaderEventCEAttr = "comgitlabevent"
+
+	glSystemHookEvent = "System Hook"
 )
 
 type envConfig struct {
@@ -42,20 +46,33 @@ type envConfig struct {
 
 	// Environment variable containing Gitlab secret token
 	EnvSecret string `envconfig:"GITLAB_SECRET_TOKEN" required:"true"`
+	// Environment variable containing the previous Gitlab secret token,
+	// which is accepted while the secret token is being rotated
+	EnvPreviousSecret string `envconfig:"GITLAB_PREVIOUS_SECRET_TOKEN"`
 	// Port to listen incoming connections
 	Port string `envconfig:"PORT" default:"8080"`
 	// Name of the event source to set as source attribute on emitted CloudEvents.
 	EventSource string `envconfig:"GITLAB_EVENT_SOURCE" required:"true"`
+	// Whether the source attribute of emitted CloudEvents should be set to
+	// the URL of the project the event originates from, when available.
+	// Used by sources which receive events from multiple projects.
+	EventSourceFromPayload bool `envconfig:"GITLAB_EVENT_SOURCE_FROM_PAYLOAD" default:"false"`
 }
 
 // gitLabReceiveAdapter converts incoming GitLab webhook events to
 // CloudEvents and then sends them to the specified Sink
 type gitLabReceiveAdapter struct {
-	logger      *zap.SugaredLogger
-	client      cloudevents.Client
-	eventSource string
-	secretToken string
-	port        string
+	logger                 *zap.SugaredLogger
+	client                 cloudevents.Client
+	eventSource            string
+	eventSourceFromPayload bool
+	secretToken            string
+	previousSecretToken    string
+	port                   string
+
+	// URL of the sink events are sent to, when it differs from the default
+	// target of the CloudEvents client.
+	sink string
 }
 
 // NewEnvConfig function reads env variables defined in envConfig structure and
@@ -70,11 +87,13 @@ func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClie
 	env := processed.(*envConfig)
 
 	return &gitLabReceiveAdapter{
-		logger:      logger,
-		client:      ceClient,
-		eventSource: env.EventSource,
-		secretToken: env.EnvSecret,
-		port:        env.Port,
+		logger:                 logger,
+		client:                 ceClient,
+		eventSource:            env.EventSource,
+		eventSourceFromPayload: env.EventSourceFromPayload,
+		secretToken:            env.EnvSecret,
+		previousSecretToken:    env.EnvPreviousSecret,
+		port:                   env.Port,
 	}
 }
 
@@ -85,6 +104,7 @@ func (ra *gitLabReceiveAdapter) Start(ctx context.Context) error {
 
 func (ra *gitLabReceiveAdapter) start(stopCh <-chan struct{}) error {
 	wh := NewWebhookHandler(ra.secretToken, ra.handleEvent)
+	wh.PreviousSecret = ra.previousSecretToken
 
 	server := &http.Server{
 		ReadTimeout:       10 * time.Second,
@@ -125,20 +145,43 @@ func gracefulShutdown(server *http.Server, logger *zap.SugaredLogger, stopCh <-c
 	}
 }
 
-func (ra *gitLabReceiveAdapter) handleEvent(payload interface{}, header http.Header) error {
+func (ra *gitLabReceiveAdapter) handleEvent(payload interface{}, body []byte, header http.Header) error {
 	eventHeader := header.Get(glHeaderEvent)
-	eventType := gitlabEventHeaderToEventType(eventHeader)
-	if eventType == "" {
-		return fmt.Errorf("invalid webhook event type %s", eventHeader)
+
+	// attributes which the typed payloads don't expose uniformly are
+	// decoded from the raw payload, only when they are needed
+	var attrs payloadAttributes
+	if eventHeader == glSystemHookEvent || ra.eventSourceFromPayload {
+		attrs = payloadAttributesFromBody(body)
 	}
 
-	ceType := sourcesv1alpha1.GitLabEventType(eventType)
+	var ceType string
+	if eventHeader == glSystemHookEvent {
+		eventName := attrs.systemEventName()
+		if eventName == "" {
+			return fmt.Errorf("invalid system hook event type")
+		}
+		ceType = sourcesv1alpha1.GitLabSystemEventType(eventName)
+	} else {
+		eventType := gitlabEventHeaderToEventType(eventHeader)
+		if eventType == "" {
+			return fmt.Errorf("invalid webhook event type %s", eventHeader)
+		}
+		ceType = sourcesv1alpha1.GitLabEventType(eventType)
+	}
 
 	extensions := map[string]interface{}{
 		glHeaderEventCEAttr: eventHeader,
 	}
 
-	return ra.postMessage(payload, ra.eventSource, ceType, extensions)
+	source := ra.eventSource
+	if ra.eventSourceFromPayload {
+		if projectURL := attrs.Project.WebURL; projectURL != "" {
+			source = projectURL
+		}
+	}
+
+	return ra.postMessage(payload, source, ceType, extensions)
 }
 
 func (ra *gitLabReceiveAdapter) postMessage(payload interface{}, source, eventType string,
@@ -156,12 +199,59 @@ func (ra *gitLabReceiveAdapter) postMessage(payload interface{}, source, eventTy
 		return fmt.Errorf("failed to marshal event data: %w", err)
 	}
 
-	if result := ra.client.Send(context.Background(), event); !cloudevents.IsACK(result) {
+	ctx := context.Background()
+	if ra.sink != "" {
+		ctx = cloudevents.ContextWithTarget(ctx, ra.sink)
+	}
+
+	if result := ra.client.Send(ctx, event); !cloudevents.IsACK(result) {
 		return result
 	}
 	return nil
 }
 
+// payloadAttributes are the attributes of GitLab webhook payloads which
+// determine the attributes of emitted CloudEvents.
+type payloadAttributes struct {
+	// URL of the GitLab project the event originates from. Empty for
+	// events which don't reference any project (e.g. subgroup events).
+	Project struct {
+		WebURL string `json:"web_url"`
+	} `json:"project"`
+
+	// Name of system hook events, and kind of the object of the event.
+	EventName  string `json:"event_name"`
+	ObjectKind string `json:"object_kind"`
+}
+
+// payloadAttributesFromBody decodes the attributes of the given raw webhook
+// payload. Attributes of payloads which can't be decoded are empty.
+func payloadAttributesFromBody(body []byte) payloadAttributes {
+	var attrs payloadAttributes
+	_ = json.Unmarshal(body, &attrs)
+	return attrs
+}
+
+// systemEventName returns the name of the event of a system hook payload.
+// Merge request events carry no event name, in which case their object kind
+// is returned instead.
+// https://docs.gitlab.com/administration/system_hooks/
+func (a payloadAttributes) systemEventName() string {
+	if a.EventName != "" {
+		return a.EventName
+	}
+	return a.ObjectKind
+}
+
+// eventTypesByHeader maps the values of X-Gitlab-Event headers which don't
+// convert to the event type declared for their webhook.
+var eventTypesByHeader = map[string]string{
+	// Confidential issues and notes have the same payloads as their
+	// non-confidential counterparts.
+	string(gitlab.EventConfidentialIssue): sourcesv1alpha1.GitLabEventTypeIssue,
+	string(gitlab.EventConfidentialNote):  sourcesv1alpha1.GitLabEventTypeNote,
+}
+
 // gitlabEventHeaderToEventType transforms the value of a X-Gitlab-Event header
 // for a webhook request into the corresponding CloudEvent event type.
 // The value of the header follows the format "Some Type Hook", which we
@@ -170,6 +260,10 @@ func (ra *gitLabReceiveAdapter) postMessage(payload interface{}, source, eventTy
 func gitlabEventHeaderToEventType(header string) string {
 	const headerSuffix = " Hook"
 
+	if typ, ok := eventTypesByHeader[header]; ok {
+		return typ
+	}
+
 	if !strings.HasSuffix(header, headerSuffix) {
 		return ""
 	}
@@ -83,7 +83,7 @@ var testCases = []testCase{
 	}, {
 		name:       "valid confidential issue event",
 		payload:    gitlab.IssueEvent{},
-		eventType:  gitlab.EventTypeIssue,
+		eventType:  gitlab.EventConfidentialIssue,
 		statusCode: 202,
 	}, {
 		name:       "valid merge request event",
@@ -105,6 +105,41 @@ var testCases = []testCase{
 		payload:    gitlab.BuildEvent{},
 		eventType:  gitlab.EventTypeBuild,
 		statusCode: 202,
+	}, {
+		name:       "valid job event",
+		payload:    gitlab.JobEvent{},
+		eventType:  gitlab.EventTypeJob,
+		statusCode: 202,
+	}, {
+		name:       "valid deployment event",
+		payload:    gitlab.DeploymentEvent{},
+		eventType:  gitlab.EventTypeDeployment,
+		statusCode: 202,
+	}, {
+		name:       "valid feature flag event",
+		payload:    gitlab.FeatureFlagEvent{},
+		eventType:  gitlab.EventTypeFeatureFlag,
+		statusCode: 202,
+	}, {
+		name:       "valid member event",
+		payload:    gitlab.MemberEvent{},
+		eventType:  gitlab.EventTypeMember,
+		statusCode: 202,
+	}, {
+		name:       "valid release event",
+		payload:    gitlab.ReleaseEvent{},
+		eventType:  gitlab.EventTypeRelease,
+		statusCode: 202,
+	}, {
+		name:       "valid resource access token event",
+		payload:    gitlab.ProjectResourceAccessTokenEvent{},
+		eventType:  gitlab.EventTypeResourceAccessToken,
+		statusCode: 202,
+	}, {
+		name:       "valid subgroup event",
+		payload:    gitlab.SubGroupEvent{},
+		eventType:  gitlab.EventTypeSubGroup,
+		statusCode: 202,
 	}, {
 		name:       "invalid nil payload",
 		payload:    []byte("{\"key\": \"value\""),
@@ -148,6 +183,51 @@ func TestServer(t *testing.T) {
 	}
 }
 
+func TestSecretTokenRotation(t *testing.T) {
+	testCases := map[string]struct {
+		token      string
+		statusCode int
+	}{
+		"new token": {
+			token:      secretToken,
+			statusCode: 202,
+		},
+		"previous token": {
+			token:      "previoussecret",
+			statusCode: 202,
+		},
+		"invalid token": {
+			token:      "wrong",
+			statusCode: 400,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			ra := newTestAdapter(t, ce)
+			hook := NewWebhookHandler(ra.secretToken, ra.handleEvent)
+			hook.PreviousSecret = "previoussecret"
+			server := httptest.NewServer(hook)
+			defer server.Close()
+
+			reqBody, err := json.Marshal(gitlab.PushEvent{ObjectKind: "push"})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest("POST", server.URL, bytes.NewReader(reqBody))
+			require.NoError(t, err)
+			req.Header.Set("X-Gitlab-Event", string(gitlab.EventTypePush))
+			req.Header.Set("X-Gitlab-Token", tc.token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.statusCode, resp.StatusCode)
+		})
+	}
+}
+
 func newTestAdapter(t *testing.T, ce cloudevents.Client) *gitLabReceiveAdapter {
 	env := envConfig{
 		EnvConfig: adapter.EnvConfig{
@@ -242,6 +322,18 @@ func TestGitLabEventHeaderToEventType(t *testing.T) {
 			input:  "Legit Event Type Hook",
 			expect: "legit_event_type",
 		},
+		"legacy job header": {
+			input:  "Build Hook",
+			expect: "build",
+		},
+		"job header": {
+			input:  "Job Hook",
+			expect: "job",
+		},
+		"confidential header": {
+			input:  "Confidential Note Hook",
+			expect: "note",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -250,3 +342,109 @@ func TestGitLabEventHeaderToEventType(t *testing.T) {
 		})
 	}
 }
+
+func TestEventSourceFromPayload(t *testing.T) {
+	const groupURL = "http://gitlab.example.com/mygroup"
+
+	testCases := map[string]struct {
+		payload      interface{}
+		eventType    gitlab.EventType
+		expectSource string
+	}{
+		"project event": {
+			payload: func() interface{} {
+				e := &gitlab.PushEvent{}
+				e.Project.WebURL = projectURL
+				return e
+			}(),
+			eventType:    gitlab.EventTypePush,
+			expectSource: projectURL,
+		},
+		"event without project": {
+			payload:      gitlab.SubGroupEvent{},
+			eventType:    gitlab.EventTypeSubGroup,
+			expectSource: groupURL,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			ra := newTestAdapter(t, ce)
+			ra.eventSource = groupURL
+			ra.eventSourceFromPayload = true
+
+			header := http.Header{}
+			header.Set(glHeaderEvent, string(tc.eventType))
+
+			require.NoError(t, ra.handleEvent(tc.payload, marshalPayload(t, tc.payload), header))
+			require.Len(t, ce.Sent(), 1)
+			assert.Equal(t, tc.expectSource, ce.Sent()[0].Source())
+		})
+	}
+}
+
+func TestSystemHookEventType(t *testing.T) {
+	testCases := map[string]struct {
+		payload interface{}
+		// Raw body of the request, when it differs from the marshaled
+		// payload.
+		body         string
+		expectCEType string
+	}{
+		"named event": {
+			payload: func() interface{} {
+				e := &gitlab.ProjectSystemEvent{}
+				e.EventName = "project_create"
+				return e
+			}(),
+			expectCEType: "dev.knative.sources.gitlab.system.project_create",
+		},
+		"merge request event": {
+			payload:      &gitlab.MergeEvent{ObjectKind: "merge_request"},
+			expectCEType: "dev.knative.sources.gitlab.system.merge_request",
+		},
+		"named event without object kind": {
+			payload:      &gitlab.UserSystemEvent{},
+			body:         `{"event_name":"user_create","created_at":"2026-10-17T10:00:00Z","username":"jdoe"}`,
+			expectCEType: "dev.knative.sources.gitlab.system.user_create",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			ra := newTestAdapter(t, ce)
+
+			header := http.Header{}
+			header.Set(glHeaderEvent, string(gitlab.EventTypeSystemHook))
+
+			body := []byte(tc.body)
+			if tc.body == "" {
+				body = marshalPayload(t, tc.payload)
+			}
+
+			require.NoError(t, ra.handleEvent(tc.payload, body, header))
+			require.Len(t, ce.Sent(), 1)
+			assert.Equal(t, tc.expectCEType, ce.Sent()[0].Type())
+		})
+	}
+
+	t.Run("unnamed event", func(t *testing.T) {
+		ce := adaptertest.NewTestClient()
+		ra := newTestAdapter(t, ce)
+
+		header := http.Header{}
+		header.Set(glHeaderEvent, string(gitlab.EventTypeSystemHook))
+
+		assert.Error(t, ra.handleEvent(struct{}{}, []byte("{}"), header))
+		assert.Empty(t, ce.Sent())
+	})
+}
+
+// marshalPayload returns the raw form of the given webhook payload.
+func marshalPayload(t *testing.T, payload interface{}) []byte {
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return body
+}
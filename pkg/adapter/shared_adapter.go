@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	sourceinformer "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1beta1/gitlabsource"
+	listersv1beta1 "knative.dev/eventing-gitlab/pkg/client/listers/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+var (
+	ErrUnknownSource     = errors.New("unknown event source")
+	ErrSourceNotReady    = errors.New("event source is not ready")
+	ErrInvalidSourcePath = errors.New("request path must have the format /<namespace>/<name>")
+)
+
+type sharedEnvConfig struct {
+	adapter.EnvConfig
+
+	// Port to listen incoming connections
+	Port string `envconfig:"PORT" default:"8080"`
+
+	// Mode of receive adapters whose source doesn't specify one. Must match
+	// the mode of the controller.
+	DefaultAdapterMode string `envconfig:"GL_RA_MODE" default:"KnativeService"`
+}
+
+// sharedReceiveAdapter converts incoming GitLab webhook events to CloudEvents
+// on behalf of all GitLabSources which use the shared adapter mode, and sends
+// them to the sink of their source.
+//
+// Sources are identified by the path of the URL of their hooks. Their secret
+// token and sink are looked up for each request, so that sources can be added,
+// updated and removed without restarting the adapter.
+type sharedReceiveAdapter struct {
+	logger *zap.SugaredLogger
+	client cloudevents.Client
+	port   string
+
+	srcLister    listersv1beta1.GitLabSourceLister
+	secretLister corelistersv1.SecretLister
+
+	// Mode of receive adapters whose source doesn't specify one.
+	defaultMode v1beta1.AdapterMode
+
+	// Informers whose cache must be synced before requests are accepted.
+	hasSynced []cache.InformerSynced
+}
+
+// NewSharedEnvConfig function reads env variables defined in sharedEnvConfig
+// structure and returns accessor interface
+func NewSharedEnvConfig() adapter.EnvConfigAccessor {
+	return &sharedEnvConfig{}
+}
+
+// NewSharedAdapter returns the instance of sharedReceiveAdapter that
+// implements adapter.Adapter interface
+func NewSharedAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*sharedEnvConfig)
+
+	srcInformer := sourceinformer.Get(ctx)
+	secretInformer := filteredsecretinformer.Get(ctx, secret.WatchLabelSelector)
+
+	return &sharedReceiveAdapter{
+		logger:       logging.FromContext(ctx),
+		client:       ceClient,
+		port:         env.Port,
+		srcLister:    srcInformer.Lister(),
+		secretLister: secretInformer.Lister(),
+		defaultMode:  v1beta1.AdapterMode(env.DefaultAdapterMode),
+		hasSynced: []cache.InformerSynced{
+			srcInformer.Informer().HasSynced,
+			secretInformer.Informer().HasSynced,
+		},
+	}
+}
+
+// Start implements adapter.Adapter
+func (ra *sharedReceiveAdapter) Start(ctx context.Context) error {
+	// requests received before the caches are synced would be rejected
+	// for sources which exist
+	if !cache.WaitForCacheSync(ctx.Done(), ra.hasSynced...) {
+		return errors.New("failed to sync the informer caches")
+	}
+
+	server := &http.Server{
+		ReadTimeout:       10 * time.Second,
+		ReadHeaderTimeout: 2 * time.Second,
+		Addr:              ":" + ra.port,
+		Handler:           ra,
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		gracefulShutdown(server, ra.logger, ctx.Done())
+	}()
+
+	ra.logger.Info("Server is ready to handle requests at ", server.Addr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("could not listen on %s: %v", server.Addr, err)
+	}
+
+	wg.Wait()
+	ra.logger.Info("Server stopped")
+	return nil
+}
+
+// ServeHTTP routes GitLab events to the webhook handler of the source
+// identified by the request's path.
+func (ra *sharedReceiveAdapter) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	namespace, name, ok := sourceFromPath(request.URL.Path)
+	if !ok {
+		writer.WriteHeader(404)
+		fmt.Fprint(writer, ErrInvalidSourcePath)
+		return
+	}
+
+	src, err := ra.srcLister.GitLabSources(namespace).Get(name)
+	switch {
+	case apierrors.IsNotFound(err), err == nil && !ra.serves(src):
+		// sources with a dedicated receive adapter are reported as
+		// unknown, so that requests without a valid token can't tell
+		// them apart from sources which don't exist
+		writer.WriteHeader(404)
+		fmt.Fprintf(writer, "%v: %s/%s", ErrUnknownSource, namespace, name)
+		return
+	case err != nil:
+		writer.WriteHeader(500)
+		fmt.Fprintf(writer, "%v: %v", ErrUnknownSource, err)
+		return
+	}
+
+	if src.Status.SinkURI == nil {
+		writer.WriteHeader(503)
+		fmt.Fprintf(writer, "%v: no sink", ErrSourceNotReady)
+		return
+	}
+
+	secretToken, err := ra.secretToken(src)
+	if err != nil {
+		writer.WriteHeader(503)
+		fmt.Fprintf(writer, "%v: %v", ErrSourceNotReady, err)
+		return
+	}
+
+	tenant := &gitLabReceiveAdapter{
+		logger:                 ra.logger,
+		client:                 ra.client,
+		eventSource:            src.AsEventSource(),
+		eventSourceFromPayload: src.IsMultiProjectSource(),
+		sink:                   src.Status.SinkURI.String(),
+	}
+
+	wh := NewWebhookHandler(secretToken, tenant.handleEvent)
+	wh.PreviousSecret = ra.previousSecretToken(src)
+	wh.ServeHTTP(writer, request)
+}
+
+// serves returns whether the given source uses the shared receive adapter.
+func (ra *sharedReceiveAdapter) serves(src *v1beta1.GitLabSource) bool {
+	mode := src.Spec.AdapterMode
+	if mode == "" {
+		mode = ra.defaultMode
+	}
+	return mode == v1beta1.AdapterModeShared
+}
+
+// secretToken returns the secret token of the given source. An error is
+// returned when the token is empty, since the webhook handler would otherwise
+// accept requests without verifying their token.
+func (ra *sharedReceiveAdapter) secretToken(src *v1beta1.GitLabSource) (string, error) {
+	ref := src.SecretTokenRef()
+	if ref == nil {
+		return "", errors.New("no secret token")
+	}
+
+	secr, err := ra.secretLister.Secrets(src.Namespace).Get(ref.Name)
+	if err != nil {
+		return "", fmt.Errorf("getting secret token: %w", err)
+	}
+
+	token := string(secr.Data[ref.Key])
+	if token == "" {
+		return "", fmt.Errorf("secret %q has no value for key %q", ref.Name, ref.Key)
+	}
+
+	return token, nil
+}
+
+// previousSecretToken returns the previous secret token of the given source
+// while its secret token is being rotated, or an empty string.
+func (ra *sharedReceiveAdapter) previousSecretToken(src *v1beta1.GitLabSource) string {
+	ref := src.PreviousSecretTokenRef()
+	if ref == nil {
+		return ""
+	}
+
+	secr, err := ra.secretLister.Secrets(src.Namespace).Get(ref.Name)
+	if err != nil {
+		return ""
+	}
+
+	return string(secr.Data[ref.Key])
+}
+
+// sourceFromPath returns the namespace and name of the source identified by
+// the given URL path, which has the format /<namespace>/<name>.
+func sourceFromPath(path string) (namespace, name string, ok bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
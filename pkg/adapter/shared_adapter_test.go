@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	listersv1beta1 "knative.dev/eventing-gitlab/pkg/client/listers/sources/v1beta1"
+)
+
+func TestSharedAdapterRouting(t *testing.T) {
+	const (
+		ns  = "default"
+		src = "my-source"
+	)
+
+	testCases := map[string]struct {
+		path       string
+		token      string
+		statusCode int
+	}{
+		"valid request": {
+			path:       "/" + ns + "/" + src,
+			token:      secretToken,
+			statusCode: 202,
+		},
+		"invalid token": {
+			path:       "/" + ns + "/" + src,
+			token:      "wrong",
+			statusCode: 400,
+		},
+		"unknown source": {
+			path:       "/" + ns + "/other-source",
+			token:      secretToken,
+			statusCode: 404,
+		},
+		"source without sink": {
+			path:       "/" + ns + "/no-sink",
+			token:      secretToken,
+			statusCode: 503,
+		},
+		"source without secret token": {
+			path:       "/" + ns + "/no-token",
+			token:      "",
+			statusCode: 503,
+		},
+		"previous token during rotation": {
+			path:       "/" + ns + "/rotating",
+			token:      "previoussecret",
+			statusCode: 202,
+		},
+		"previous token outside of rotations": {
+			path:       "/" + ns + "/" + src,
+			token:      "previoussecret",
+			statusCode: 400,
+		},
+		"source with dedicated adapter": {
+			path:       "/" + ns + "/dedicated",
+			token:      secretToken,
+			statusCode: 404,
+		},
+		"source with default adapter mode": {
+			path:       "/" + ns + "/default-mode",
+			token:      secretToken,
+			statusCode: 404,
+		},
+		"invalid path": {
+			path:       "/" + ns,
+			token:      secretToken,
+			statusCode: 404,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			server := httptest.NewServer(newTestSharedAdapter(t, ce))
+			defer server.Close()
+
+			payload := gitlab.PushEvent{ObjectKind: "push"}
+			reqBody, err := json.Marshal(payload)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest("POST", server.URL+tc.path, bytes.NewReader(reqBody))
+			require.NoError(t, err)
+			req.Header.Set("X-Gitlab-Event", string(gitlab.EventTypePush))
+			req.Header.Set("X-Gitlab-Token", tc.token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.statusCode, resp.StatusCode)
+
+			if tc.statusCode/100 != 2 {
+				assert.Len(t, ce.Sent(), 0, "Event sent despite the non-success HTTP code")
+				return
+			}
+			require.Len(t, ce.Sent(), 1)
+			assert.Equal(t, projectURL, ce.Sent()[0].Source(),
+				"CloudEvent source doesn't match the source's project URL")
+		})
+	}
+}
+
+// TestSharedAdapterUnservedSources ensures that sources which aren't served by
+// the shared adapter can't be told apart from sources which don't exist.
+func TestSharedAdapterUnservedSources(t *testing.T) {
+	server := httptest.NewServer(newTestSharedAdapter(t, adaptertest.NewTestClient()))
+	defer server.Close()
+
+	post := func(path string) (int, string) {
+		req, err := http.NewRequest("POST", server.URL+path, bytes.NewReader([]byte("{}")))
+		require.NoError(t, err)
+		req.Header.Set("X-Gitlab-Event", string(gitlab.EventTypePush))
+		req.Header.Set("X-Gitlab-Token", "wrong")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, strings.ReplaceAll(string(body), path[1:], "")
+	}
+
+	unknownCode, unknownBody := post("/default/other-source")
+	dedicatedCode, dedicatedBody := post("/default/dedicated")
+
+	assert.Equal(t, http.StatusNotFound, unknownCode)
+	assert.Equal(t, unknownCode, dedicatedCode, "status of source with dedicated adapter")
+	assert.Equal(t, unknownBody, dedicatedBody, "response to source with dedicated adapter")
+}
+
+func newTestSharedAdapter(t *testing.T, ce *adaptertest.TestCloudEventsClient) *sharedReceiveAdapter {
+	newSource := func(name string, sink *apis.URL, token *v1beta1.SecretValueFromSource) *v1beta1.GitLabSource {
+		src := &v1beta1.GitLabSource{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: v1beta1.GitLabSourceSpec{
+				ProjectURL:  projectURL,
+				SecretToken: token,
+				AdapterMode: v1beta1.AdapterModeShared,
+			},
+		}
+		src.Status.SinkURI = sink
+		return src
+	}
+
+	sink := apis.HTTP("sink.default.svc.cluster.local")
+	token := &v1beta1.SecretValueFromSource{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "gitlab-secret"},
+			Key:                  "secretToken",
+		},
+	}
+
+	rotating := newSource("rotating", sink, token)
+	rotating.Status.SecretTokenRotation = &v1beta1.SecretTokenRotationStatus{
+		PreviousSecretName: "rotating-applied-secret-token",
+	}
+
+	dedicated := newSource("dedicated", sink, token)
+	dedicated.Spec.AdapterMode = v1beta1.AdapterModeDeployment
+
+	defaultMode := newSource("default-mode", sink, token)
+	defaultMode.Spec.AdapterMode = ""
+
+	srcIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, src := range []*v1beta1.GitLabSource{
+		newSource("my-source", sink, token),
+		newSource("no-sink", nil, token),
+		newSource("no-token", sink, nil),
+		rotating,
+		dedicated,
+		defaultMode,
+	} {
+		require.NoError(t, srcIndexer.Add(src))
+	}
+
+	secretIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, secretIndexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gitlab-secret"},
+		Data:       map[string][]byte{"secretToken": []byte(secretToken)},
+	}))
+	require.NoError(t, secretIndexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rotating-applied-secret-token"},
+		Data:       map[string][]byte{v1beta1.GeneratedSecretTokenKey: []byte("previoussecret")},
+	}))
+
+	return &sharedReceiveAdapter{
+		logger:       zap.NewExample().Sugar(),
+		client:       ce,
+		srcLister:    listersv1beta1.NewGitLabSourceLister(srcIndexer),
+		secretLister: corelistersv1.NewSecretLister(secretIndexer),
+		defaultMode:  v1beta1.AdapterModeKnativeService,
+	}
+}
@@ -38,12 +38,18 @@ var (
 	ErrCouldNotHandleEvent           = errors.New("error handling the event")
 )
 
-type EventSender func(payload interface{}, header http.Header) error
+// EventSender handles a parsed GitLab event, whose raw payload is given
+// alongside the parsed one.
+type EventSender func(payload interface{}, body []byte, header http.Header) error
 
 // webhook is a HTTP Handler for Gitlab Webhook events.
 type webhook struct {
 	Secret      string
 	EventSender EventSender
+
+	// PreviousSecret is accepted alongside Secret while the secret token
+	// is being rotated.
+	PreviousSecret string
 }
 
 // NewWebhookHandler provide a webhook receiver that parses Gitlab events and emits CloudEvents.
@@ -59,7 +65,7 @@ func NewWebhookHandler(secret string, sender EventSender) webhook {
 // ServeHTTP tries to parse Gitlab events sent and calls handle function
 // with the successfully parsed events.
 func (hook webhook) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	event, err := hook.parse(request)
+	event, body, err := hook.parse(request)
 	if err != nil {
 		writer.WriteHeader(400)
 		fmt.Fprintf(writer, "%v: %v", ErrCouldNotParseWebhookEvent, err)
@@ -67,7 +73,7 @@ func (hook webhook) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 	}
 
 	// Handle the event before we return.
-	if err := hook.EventSender(event, request.Header); err != nil {
+	if err := hook.EventSender(event, body, request.Header); err != nil {
 		writer.WriteHeader(500)
 		fmt.Fprintf(writer, "%v: %v", ErrCouldNotHandleEvent, err)
 		return
@@ -78,8 +84,8 @@ func (hook webhook) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 }
 
 // parse verifies and parses the events specified in the request and
-// returns the parsed event or an error.
-func (hook webhook) parse(r *http.Request) (any, error) {
+// returns the parsed event together with the raw payload, or an error.
+func (hook webhook) parse(r *http.Request) (any, []byte, error) {
 	defer func() {
 		if _, err := io.Copy(io.Discard, r.Body); err != nil {
 			log.Printf("could discard request body: %v", err)
@@ -90,28 +96,33 @@ func (hook webhook) parse(r *http.Request) (any, error) {
 	}()
 
 	if r.Method != http.MethodPost {
-		return nil, errors.New("invalid HTTP Method")
+		return nil, nil, errors.New("invalid HTTP Method")
 	}
 
 	// If we have a secret set, we should check if the request matches it.
 	if len(hook.Secret) > 0 {
 		signature := r.Header.Get("X-Gitlab-Token")
-		if signature != hook.Secret {
-			return nil, ErrGitLabTokenVerificationFailed
+		if signature != hook.Secret && (hook.PreviousSecret == "" || signature != hook.PreviousSecret) {
+			return nil, nil, ErrGitLabTokenVerificationFailed
 		}
 	}
 
 	event := r.Header.Get("X-Gitlab-Event")
 	if strings.TrimSpace(event) == "" {
-		return nil, ErrMissingGitLabEventHeader
+		return nil, nil, ErrMissingGitLabEventHeader
 	}
 
 	eventType := gitlab.EventType(event)
 
 	payload, err := io.ReadAll(r.Body)
 	if err != nil || len(payload) == 0 {
-		return nil, ErrReadingRequestBody
+		return nil, nil, ErrReadingRequestBody
+	}
+
+	parsed, err := gitlab.ParseHook(eventType, payload)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return gitlab.ParseWebhook(eventType, payload)
+	return parsed, payload, nil
 }
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
+)
+
+// v1beta1FieldsAnnotation is the annotation of v1alpha1 objects which carries
+// the fields of the v1beta1 object they were converted from that have no
+// equivalent in v1alpha1, so that these fields survive a round trip through
+// the v1alpha1 API. It never appears on v1beta1 objects.
+const v1beta1FieldsAnnotation = "bindings.knative.dev/v1beta1-fields"
+
+// ConvertTo implements apis.Convertible.
+// Converts source from v1alpha1.GitLabBinding into a higher version.
+func (source *GitLabBinding) ConvertTo(ctx context.Context, obj apis.Convertible) error {
+	switch sink := obj.(type) {
+	case *v1beta1.GitLabBinding:
+		fields := &v1beta1Fields{}
+		meta := *source.ObjectMeta.DeepCopy()
+		getV1beta1Fields(&meta, fields)
+
+		sink.ObjectMeta = meta
+		sink.Spec.Subject = source.Spec.Subject
+		sink.Spec.AccessToken = v1beta1.SecretValueFromSource{
+			SecretKeyRef: source.Spec.AccessToken.SecretKeyRef,
+		}
+		sink.Spec.InstanceURL = fields.Spec.InstanceURL
+		sink.Status.SourceStatus = source.Status.SourceStatus
+		sink.Status.AccessTokenExpiresAt = fields.Status.AccessTokenExpiresAt
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+// Converts obj from a higher version into v1alpha1.GitLabBinding.
+func (sink *GitLabBinding) ConvertFrom(ctx context.Context, obj apis.Convertible) error {
+	switch source := obj.(type) {
+	case *v1beta1.GitLabBinding:
+		sink.ObjectMeta = *source.ObjectMeta.DeepCopy()
+		sink.Spec.Subject = source.Spec.Subject
+		sink.Spec.AccessToken = SecretValueFromSource{
+			SecretKeyRef: source.Spec.AccessToken.SecretKeyRef,
+		}
+		sink.Status.SourceStatus = source.Status.SourceStatus
+		return setV1beta1Fields(&sink.ObjectMeta, &v1beta1Fields{
+			Spec: v1beta1SpecFields{
+				InstanceURL: source.Spec.InstanceURL,
+			},
+			Status: v1beta1StatusFields{
+				AccessTokenExpiresAt: source.Status.AccessTokenExpiresAt,
+			},
+		})
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// v1beta1Fields are the fields of a v1beta1 GitLabBinding which have no
+// equivalent in v1alpha1.
+type v1beta1Fields struct {
+	Spec   v1beta1SpecFields   `json:"spec,omitempty"`
+	Status v1beta1StatusFields `json:"status,omitempty"`
+}
+
+// v1beta1SpecFields are the spec fields of a v1beta1 GitLabBinding which have
+// no equivalent in v1alpha1.
+type v1beta1SpecFields struct {
+	InstanceURL string `json:"instanceUrl,omitempty"`
+}
+
+// v1beta1StatusFields are the status fields of a v1beta1 GitLabBinding which
+// have no equivalent in v1alpha1.
+type v1beta1StatusFields struct {
+	AccessTokenExpiresAt *metav1.Time `json:"accessTokenExpiresAt,omitempty"`
+}
+
+// setV1beta1Fields stores the given v1beta1 fields in the annotation of the
+// given object, or removes the annotation when all fields are empty.
+func setV1beta1Fields(meta *metav1.ObjectMeta, fields *v1beta1Fields) error {
+	delete(meta.Annotations, v1beta1FieldsAnnotation)
+
+	if reflect.ValueOf(fields).Elem().IsZero() {
+		if len(meta.Annotations) == 0 {
+			meta.Annotations = nil
+		}
+		return nil
+	}
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("serializing v1beta1 fields: %w", err)
+	}
+
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string, 1)
+	}
+	meta.Annotations[v1beta1FieldsAnnotation] = string(b)
+
+	return nil
+}
+
+// getV1beta1Fields reads the v1beta1 fields stored in the annotation of the
+// given object into fields, and removes the annotation.
+// A malformed annotation is ignored, since failing the conversion would make
+// the object unreadable in every version.
+func getV1beta1Fields(meta *metav1.ObjectMeta, fields *v1beta1Fields) {
+	val, ok := meta.Annotations[v1beta1FieldsAnnotation]
+	if !ok {
+		return
+	}
+
+	delete(meta.Annotations, v1beta1FieldsAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+
+	if err := json.Unmarshal([]byte(val), fields); err != nil {
+		*fields = v1beta1Fields{}
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/tracker"
+
+	"knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
+)
+
+func TestGitLabBindingConversionBadType(t *testing.T) {
+	good, bad := &GitLabBinding{}, &GitLabBinding{}
+
+	if err := good.ConvertTo(context.Background(), bad); err == nil {
+		t.Errorf("ConvertTo() = %#v, wanted error", bad)
+	}
+
+	if err := good.ConvertFrom(context.Background(), bad); err == nil {
+		t.Errorf("ConvertFrom() = %#v, wanted error", good)
+	}
+}
+
+func TestGitLabBindingConversionRoundTrip(t *testing.T) {
+	testCases := map[string]*GitLabBinding{
+		"min": {
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		},
+		"full": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "name",
+				Namespace:  "namespace",
+				Generation: 3,
+			},
+			Spec: GitLabBindingSpec{
+				BindingSpec: duckv1alpha1.BindingSpec{
+					Subject: tracker.Reference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  "namespace",
+						Name:       "app",
+					},
+				},
+				AccessToken: SecretValueFromSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+						Key:                  "accessToken",
+					},
+				},
+			},
+			Status: GitLabBindingStatus{
+				SourceStatus: duckv1.SourceStatus{
+					Status: duckv1.Status{
+						ObservedGeneration: 3,
+						Conditions: duckv1.Conditions{{
+							Type:   apis.ConditionReady,
+							Status: corev1.ConditionTrue,
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	for n, bdg := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ver := &v1beta1.GitLabBinding{}
+			if err := bdg.ConvertTo(context.Background(), ver); err != nil {
+				t.Fatal("ConvertTo() =", err)
+			}
+
+			got := &GitLabBinding{}
+			if err := got.ConvertFrom(context.Background(), ver); err != nil {
+				t.Fatal("ConvertFrom() =", err)
+			}
+
+			if diff := cmp.Diff(bdg, got); diff != "" {
+				t.Error("Roundtrip (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestGitLabBindingConversionRoundTripV1beta1(t *testing.T) {
+	testCases := map[string]*v1beta1.GitLabBinding{
+		"min": {
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		},
+		"full": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "name",
+				Namespace:   "namespace",
+				Generation:  3,
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			Spec: v1beta1.GitLabBindingSpec{
+				BindingSpec: duckv1.BindingSpec{
+					Subject: tracker.Reference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  "namespace",
+						Name:       "app",
+					},
+				},
+				AccessToken: v1beta1.SecretValueFromSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+						Key:                  "accessToken",
+					},
+				},
+				InstanceURL: "https://gitlab.example.com",
+			},
+			Status: v1beta1.GitLabBindingStatus{
+				SourceStatus: duckv1.SourceStatus{
+					Status: duckv1.Status{
+						ObservedGeneration: 3,
+						Conditions: duckv1.Conditions{{
+							Type:   v1beta1.GitLabBindingConditionCredentialsValid,
+							Status: corev1.ConditionTrue,
+						}},
+					},
+				},
+				AccessTokenExpiresAt: &metav1.Time{Time: time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	for n, bdg := range testCases {
+		t.Run(n, func(t *testing.T) {
+			down := &GitLabBinding{}
+			if err := down.ConvertFrom(context.Background(), bdg); err != nil {
+				t.Fatal("ConvertFrom() =", err)
+			}
+
+			got := &v1beta1.GitLabBinding{}
+			if err := down.ConvertTo(context.Background(), got); err != nil {
+				t.Fatal("ConvertTo() =", err)
+			}
+
+			if diff := cmp.Diff(bdg, got); diff != "" {
+				t.Error("Roundtrip (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -50,6 +50,7 @@ var (
 	_ kmeta.OwnerRefable = (*GitLabBinding)(nil)
 	_ apis.Validatable   = (*GitLabBinding)(nil)
 	_ apis.Defaultable   = (*GitLabBinding)(nil)
+	_ apis.Convertible   = (*GitLabBinding)(nil)
 	_ apis.HasSpec       = (*GitLabBinding)(nil)
 )
 
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+// v1beta1FieldsAnnotation is the annotation of v1alpha1 objects which carries
+// the fields of the v1beta1 object they were converted from that have no
+// equivalent in v1alpha1, so that these fields survive a round trip through
+// the v1alpha1 API, e.g. a read followed by an update by a v1alpha1 client.
+// It never appears on v1beta1 objects.
+const v1beta1FieldsAnnotation = "sources.knative.dev/v1beta1-fields"
+
+// ConvertTo implements apis.Convertible.
+// Converts source from v1alpha1.GitLabSource into a higher version.
+func (source *GitLabSource) ConvertTo(ctx context.Context, obj apis.Convertible) error {
+	switch sink := obj.(type) {
+	case *v1beta1.GitLabSource:
+		// the deprecated WebhookID doesn't exist in higher versions
+		src := source.DeepCopy()
+		src.MigrateDeprecatedStatus()
+
+		fields := &v1beta1Fields{}
+		getV1beta1Fields(&src.ObjectMeta, fields)
+
+		sink.ObjectMeta = src.ObjectMeta
+		src.Spec.convertTo(&sink.Spec)
+		src.Status.convertTo(&sink.Status)
+		fields.restore(sink)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+func (s *GitLabSourceSpec) convertTo(sink *v1beta1.GitLabSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ServiceAccountName = s.ServiceAccountName
+	sink.ProjectURL = s.ProjectURL
+	sink.ProjectURLs = s.ProjectURLs
+	sink.GroupURL = s.GroupURL
+	sink.EventTypes = s.EventTypes
+	if s.AccessToken.SecretKeyRef != nil {
+		sink.AccessToken = &v1beta1.SecretValueFromSource{
+			SecretKeyRef: s.AccessToken.SecretKeyRef,
+		}
+	}
+	sink.SecretToken = nil
+	if s.SecretToken != nil {
+		sink.SecretToken = &v1beta1.SecretValueFromSource{
+			SecretKeyRef: s.SecretToken.SecretKeyRef,
+		}
+	}
+	sink.SSLVerify = s.SSLVerify
+	sink.PushEventsBranchFilter = s.PushEventsBranchFilter
+	sink.BranchFilterStrategy = s.BranchFilterStrategy
+}
+
+func (s *GitLabSourceStatus) convertTo(sink *v1beta1.GitLabSourceStatus) {
+	sink.SourceStatus = s.SourceStatus
+	sink.Webhooks = nil
+	for _, hook := range s.Webhooks {
+		sink.Webhooks = append(sink.Webhooks, v1beta1.WebhookStatus{
+			ProjectURL: hook.ProjectURL,
+			GroupURL:   hook.GroupURL,
+			ID:         hook.ID,
+		})
+	}
+	sink.SecretTokenSecretName = s.SecretTokenSecretName
+}
+
+// ConvertFrom implements apis.Convertible.
+// Converts obj from a higher version into v1alpha1.GitLabSource.
+func (sink *GitLabSource) ConvertFrom(ctx context.Context, obj apis.Convertible) error {
+	switch source := obj.(type) {
+	case *v1beta1.GitLabSource:
+		sink.ObjectMeta = *source.ObjectMeta.DeepCopy()
+		sink.Spec.convertFrom(&source.Spec)
+		sink.Status.convertFrom(&source.Status)
+		return setV1beta1Fields(&sink.ObjectMeta, newV1beta1Fields(source))
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (s *GitLabSourceSpec) convertFrom(source *v1beta1.GitLabSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ServiceAccountName = source.ServiceAccountName
+	s.ProjectURL = source.ProjectURL
+	s.ProjectURLs = source.ProjectURLs
+	s.GroupURL = source.GroupURL
+	s.EventTypes = source.EventTypes
+	if source.AccessToken != nil {
+		s.AccessToken = SecretValueFromSource{
+			SecretKeyRef: source.AccessToken.SecretKeyRef,
+		}
+	}
+	s.SecretToken = nil
+	if source.SecretToken != nil {
+		s.SecretToken = &SecretValueFromSource{
+			SecretKeyRef: source.SecretToken.SecretKeyRef,
+		}
+	}
+	s.SSLVerify = source.SSLVerify
+	s.PushEventsBranchFilter = source.PushEventsBranchFilter
+	s.BranchFilterStrategy = source.BranchFilterStrategy
+}
+
+func (s *GitLabSourceStatus) convertFrom(source *v1beta1.GitLabSourceStatus) {
+	s.SourceStatus = source.SourceStatus
+	s.Webhooks = nil
+	for _, hook := range source.Webhooks {
+		s.Webhooks = append(s.Webhooks, WebhookStatus{
+			ProjectURL: hook.ProjectURL,
+			GroupURL:   hook.GroupURL,
+			ID:         hook.ID,
+		})
+	}
+	s.SecretTokenSecretName = source.SecretTokenSecretName
+	s.WebhookID = nil
+}
+
+// v1beta1Fields are the fields of a v1beta1 GitLabSource which have no
+// equivalent in v1alpha1.
+type v1beta1Fields struct {
+	Spec   v1beta1SpecFields   `json:"spec,omitempty"`
+	Status v1beta1StatusFields `json:"status,omitempty"`
+}
+
+// v1beta1SpecFields are the spec fields of a v1beta1 GitLabSource which have
+// no equivalent in v1alpha1.
+type v1beta1SpecFields struct {
+	WebhookDriftPolicy v1beta1.WebhookDriftPolicy   `json:"webhookDriftPolicy,omitempty"`
+	DeletionPolicy     v1beta1.DeletionPolicy       `json:"deletionPolicy,omitempty"`
+	AdoptWebhooksFrom  *v1beta1.RetainedWebhooksRef `json:"adoptWebhooksFrom,omitempty"`
+	AdapterMode        v1beta1.AdapterMode          `json:"adapterMode,omitempty"`
+	Exposure           *v1beta1.AdapterExposure     `json:"exposure,omitempty"`
+	WebhookURL         *apis.URL                    `json:"webhookURL,omitempty"`
+	Adapter            *v1beta1.AdapterTemplate     `json:"adapter,omitempty"`
+}
+
+// v1beta1StatusFields are the status fields of a v1beta1 GitLabSource which
+// have no equivalent in v1alpha1.
+type v1beta1StatusFields struct {
+	// Hooks whose observed attributes are reported.
+	Webhooks []v1beta1.WebhookStatus `json:"webhooks,omitempty"`
+
+	SecretTokenRotation     *v1beta1.SecretTokenRotationStatus `json:"secretTokenRotation,omitempty"`
+	AccessTokenExpiresAt    *metav1.Time                       `json:"accessTokenExpiresAt,omitempty"`
+	AccessTokenSecretName   string                             `json:"accessTokenSecretName,omitempty"`
+	ProvisionedAccessTokens []v1beta1.ProvisionedAccessToken   `json:"provisionedAccessTokens,omitempty"`
+}
+
+// newV1beta1Fields returns the fields of the given v1beta1 GitLabSource which
+// have no equivalent in v1alpha1.
+func newV1beta1Fields(source *v1beta1.GitLabSource) *v1beta1Fields {
+	f := &v1beta1Fields{
+		Spec: v1beta1SpecFields{
+			WebhookDriftPolicy: source.Spec.WebhookDriftPolicy,
+			DeletionPolicy:     source.Spec.DeletionPolicy,
+			AdoptWebhooksFrom:  source.Spec.AdoptWebhooksFrom,
+			AdapterMode:        source.Spec.AdapterMode,
+			Exposure:           source.Spec.Exposure,
+			WebhookURL:         source.Spec.WebhookURL,
+			Adapter:            source.Spec.Adapter,
+		},
+		Status: v1beta1StatusFields{
+			SecretTokenRotation:     source.Status.SecretTokenRotation,
+			AccessTokenExpiresAt:    source.Status.AccessTokenExpiresAt,
+			AccessTokenSecretName:   source.Status.AccessTokenSecretName,
+			ProvisionedAccessTokens: source.Status.ProvisionedAccessTokens,
+		},
+	}
+
+	for _, hook := range source.Status.Webhooks {
+		if hasObservedAttributes(hook) {
+			f.Status.Webhooks = append(f.Status.Webhooks, *hook.DeepCopy())
+		}
+	}
+
+	return f
+}
+
+// restore sets the fields of the given v1beta1 GitLabSource which have no
+// equivalent in v1alpha1.
+func (f *v1beta1Fields) restore(sink *v1beta1.GitLabSource) {
+	sink.Spec.WebhookDriftPolicy = f.Spec.WebhookDriftPolicy
+	sink.Spec.DeletionPolicy = f.Spec.DeletionPolicy
+	sink.Spec.AdoptWebhooksFrom = f.Spec.AdoptWebhooksFrom
+	sink.Spec.AdapterMode = f.Spec.AdapterMode
+	sink.Spec.Exposure = f.Spec.Exposure
+	sink.Spec.WebhookURL = f.Spec.WebhookURL
+	sink.Spec.Adapter = f.Spec.Adapter
+	sink.Status.SecretTokenRotation = f.Status.SecretTokenRotation
+	sink.Status.AccessTokenExpiresAt = f.Status.AccessTokenExpiresAt
+	sink.Status.AccessTokenSecretName = f.Status.AccessTokenSecretName
+	sink.Status.ProvisionedAccessTokens = f.Status.ProvisionedAccessTokens
+
+	for i := range sink.Status.Webhooks {
+		hook := &sink.Status.Webhooks[i]
+		for _, observed := range f.Status.Webhooks {
+			if observed.ProjectURL == hook.ProjectURL && observed.GroupURL == hook.GroupURL && observed.ID == hook.ID {
+				*hook = observed
+			}
+		}
+	}
+}
+
+// hasObservedAttributes returns whether the given hook status reports
+// attributes beyond the hook's identity, which is represented in v1alpha1.
+func hasObservedAttributes(hook v1beta1.WebhookStatus) bool {
+	hook.ProjectURL, hook.GroupURL, hook.ID = "", "", 0
+	return !reflect.ValueOf(hook).IsZero()
+}
+
+// setV1beta1Fields stores the given v1beta1 fields in the annotation of the
+// given object, or removes the annotation when all fields are empty.
+func setV1beta1Fields(meta *metav1.ObjectMeta, fields any) error {
+	delete(meta.Annotations, v1beta1FieldsAnnotation)
+
+	if reflect.ValueOf(fields).Elem().IsZero() {
+		if len(meta.Annotations) == 0 {
+			meta.Annotations = nil
+		}
+		return nil
+	}
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("serializing v1beta1 fields: %w", err)
+	}
+
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string, 1)
+	}
+	meta.Annotations[v1beta1FieldsAnnotation] = string(b)
+
+	return nil
+}
+
+// getV1beta1Fields reads the v1beta1 fields stored in the annotation of the
+// given object into fields, and removes the annotation.
+// A malformed annotation is ignored, since failing the conversion would make
+// the object unreadable in every version.
+func getV1beta1Fields(meta *metav1.ObjectMeta, fields any) {
+	val, ok := meta.Annotations[v1beta1FieldsAnnotation]
+	if !ok {
+		return
+	}
+
+	delete(meta.Annotations, v1beta1FieldsAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+
+	if err := json.Unmarshal([]byte(val), fields); err != nil {
+		v := reflect.ValueOf(fields).Elem()
+		v.Set(reflect.Zero(v.Type()))
+	}
+}
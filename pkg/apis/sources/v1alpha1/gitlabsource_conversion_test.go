@@ -0,0 +1,370 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+func TestGitLabSourceConversionBadType(t *testing.T) {
+	good, bad := &GitLabSource{}, &GitLabSource{}
+
+	if err := good.ConvertTo(context.Background(), bad); err == nil {
+		t.Errorf("ConvertTo() = %#v, wanted error", bad)
+	}
+
+	if err := good.ConvertFrom(context.Background(), bad); err == nil {
+		t.Errorf("ConvertFrom() = %#v, wanted error", good)
+	}
+}
+
+func TestGitLabSourceConversionRoundTripV1alpha1(t *testing.T) {
+	testCases := map[string]*GitLabSource{
+		"min": {
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		},
+		"full": newFullGitLabSource(),
+	}
+
+	for n, src := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ver := &v1beta1.GitLabSource{}
+			if err := src.ConvertTo(context.Background(), ver); err != nil {
+				t.Fatal("ConvertTo() =", err)
+			}
+
+			got := &GitLabSource{}
+			if err := got.ConvertFrom(context.Background(), ver); err != nil {
+				t.Fatal("ConvertFrom() =", err)
+			}
+
+			if diff := cmp.Diff(src, got); diff != "" {
+				t.Error("Roundtrip (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestGitLabSourceConversionRoundTripV1beta1(t *testing.T) {
+	testCases := map[string]*v1beta1.GitLabSource{
+		"min": {
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		},
+		"full": newFullV1beta1GitLabSource(),
+	}
+
+	for n, src := range testCases {
+		t.Run(n, func(t *testing.T) {
+			down := &GitLabSource{}
+			if err := down.ConvertFrom(context.Background(), src); err != nil {
+				t.Fatal("ConvertFrom() =", err)
+			}
+
+			got := &v1beta1.GitLabSource{}
+			if err := down.ConvertTo(context.Background(), got); err != nil {
+				t.Fatal("ConvertTo() =", err)
+			}
+
+			if diff := cmp.Diff(src, got); diff != "" {
+				t.Error("Roundtrip (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+// TestGitLabSourceConversionFullV1beta1Fixture ensures that the fixture of the
+// v1beta1 round-trip test sets every field, so that fields added to the
+// v1beta1 API without a conversion are caught.
+func TestGitLabSourceConversionFullV1beta1Fixture(t *testing.T) {
+	src := newFullV1beta1GitLabSource()
+
+	// mutually exclusive with the project URLs, and converted as is
+	exempt := map[string]bool{"GroupURL": true}
+
+	for name, obj := range map[string]any{
+		"spec":        src.Spec,
+		"status":      src.Status,
+		"webhooks[0]": src.Status.Webhooks[0],
+	} {
+		v := reflect.ValueOf(obj)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).IsZero() && !exempt[v.Type().Field(i).Name] {
+				t.Errorf("%s.%s isn't set", name, v.Type().Field(i).Name)
+			}
+		}
+	}
+}
+
+func TestGitLabSourceConversionV1beta1FieldsAnnotation(t *testing.T) {
+	src := newFullV1beta1GitLabSource()
+	src.Annotations = map[string]string{"foo": "bar"}
+
+	down := &GitLabSource{}
+	if err := down.ConvertFrom(context.Background(), src); err != nil {
+		t.Fatal("ConvertFrom() =", err)
+	}
+	if _, ok := src.Annotations[v1beta1FieldsAnnotation]; ok {
+		t.Error("ConvertFrom() mutated the converted object")
+	}
+
+	// malformed annotations are ignored
+	down.Annotations[v1beta1FieldsAnnotation] = "{"
+
+	got := &v1beta1.GitLabSource{}
+	if err := down.ConvertTo(context.Background(), got); err != nil {
+		t.Fatal("ConvertTo() =", err)
+	}
+
+	if diff := cmp.Diff(map[string]string{"foo": "bar"}, got.Annotations); diff != "" {
+		t.Error("Annotations (-want, +got) =", diff)
+	}
+	if _, ok := down.Annotations[v1beta1FieldsAnnotation]; !ok {
+		t.Error("ConvertTo() mutated the converted object")
+	}
+}
+
+func TestGitLabSourceConversionDeprecatedWebhookID(t *testing.T) {
+	const projectURL = "https://gitlab.example.com/mygroup/myproject"
+
+	hookID := 42
+
+	src := &GitLabSource{
+		Spec:   GitLabSourceSpec{ProjectURL: projectURL},
+		Status: GitLabSourceStatus{WebhookID: &hookID},
+	}
+
+	got := &v1beta1.GitLabSource{}
+	if err := src.ConvertTo(context.Background(), got); err != nil {
+		t.Fatal("ConvertTo() =", err)
+	}
+
+	want := []v1beta1.WebhookStatus{{ProjectURL: projectURL, ID: hookID}}
+	if diff := cmp.Diff(want, got.Status.Webhooks); diff != "" {
+		t.Error("Webhooks (-want, +got) =", diff)
+	}
+
+	if src.Status.WebhookID == nil {
+		t.Error("ConvertTo() mutated the converted object")
+	}
+}
+
+// newFullGitLabSource returns a GitLabSource with all attributes set.
+func newFullGitLabSource() *GitLabSource {
+	return &GitLabSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "name",
+			Namespace:  "namespace",
+			Generation: 17,
+		},
+		Spec: GitLabSourceSpec{
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{URI: apis.HTTP("sink.example.com")},
+				CloudEventOverrides: &duckv1.CloudEventOverrides{
+					Extensions: map[string]string{"foo": "bar"},
+				},
+			},
+			ServiceAccountName: "gitlab-adapter",
+			ProjectURL:         "https://gitlab.example.com/mygroup/myproject",
+			ProjectURLs:        []string{"https://gitlab.example.com/mygroup/otherproject"},
+			EventTypes:         []string{GitLabWebhookIssues, GitLabWebhookPush},
+			AccessToken: SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "accessToken",
+				},
+			},
+			SecretToken: &SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "secretToken",
+				},
+			},
+			SSLVerify:              true,
+			PushEventsBranchFilter: "release/*",
+			BranchFilterStrategy:   BranchFilterStrategyWildcard,
+		},
+		Status: GitLabSourceStatus{
+			SourceStatus: duckv1.SourceStatus{
+				Status: duckv1.Status{
+					ObservedGeneration: 17,
+					Conditions: duckv1.Conditions{{
+						Type:   apis.ConditionReady,
+						Status: corev1.ConditionTrue,
+					}},
+				},
+				SinkURI: apis.HTTP("sink.example.com"),
+				CloudEventAttributes: []duckv1.CloudEventAttributes{{
+					Type:   "dev.knative.sources.gitlab.push",
+					Source: "https://gitlab.example.com/mygroup/myproject",
+				}},
+			},
+			Webhooks: []WebhookStatus{{
+				ProjectURL: "https://gitlab.example.com/mygroup/myproject",
+				ID:         1,
+			}, {
+				ProjectURL: "https://gitlab.example.com/mygroup/otherproject",
+				ID:         2,
+			}},
+			SecretTokenSecretName: "name-secret-token",
+		},
+	}
+}
+
+// newFullV1beta1GitLabSource returns a v1beta1 GitLabSource with all
+// attributes set, including the ones which have no equivalent in v1alpha1.
+func newFullV1beta1GitLabSource() *v1beta1.GitLabSource {
+	return &v1beta1.GitLabSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "name",
+			Namespace:  "namespace",
+			Generation: 17,
+		},
+		Spec: v1beta1.GitLabSourceSpec{
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{URI: apis.HTTP("sink.example.com")},
+				CloudEventOverrides: &duckv1.CloudEventOverrides{
+					Extensions: map[string]string{"foo": "bar"},
+				},
+			},
+			ServiceAccountName: "gitlab-adapter",
+			ProjectURL:         "https://gitlab.example.com/mygroup/myproject",
+			ProjectURLs:        []string{"https://gitlab.example.com/mygroup/otherproject"},
+			EventTypes:         []string{v1beta1.GitLabWebhookIssues, v1beta1.GitLabWebhookPush},
+			AccessToken: &v1beta1.SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "accessToken",
+				},
+			},
+			SecretToken: &v1beta1.SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "secretToken",
+				},
+			},
+			SSLVerify:              true,
+			PushEventsBranchFilter: "release/*",
+			BranchFilterStrategy:   v1beta1.BranchFilterStrategyWildcard,
+			WebhookDriftPolicy:     v1beta1.WebhookDriftPolicyReport,
+			DeletionPolicy:         v1beta1.DeletionPolicyRetain,
+			AdoptWebhooksFrom: &v1beta1.RetainedWebhooksRef{
+				Namespace: "previous",
+				Name:      "name",
+				Cluster:   "cluster-a",
+			},
+			AdapterMode: v1beta1.AdapterModeDeployment,
+			Exposure: &v1beta1.AdapterExposure{
+				Type:             v1beta1.ExposureTypeHTTPRoute,
+				Host:             "gitlab.example.com",
+				Path:             "/hooks/name",
+				Gateway:          &v1beta1.GatewayReference{Namespace: "gateways", Name: "public"},
+				IngressClassName: "nginx",
+				TLSSecretName:    "gitlab-tls",
+			},
+			WebhookURL: apis.HTTPS("hooks.example.com"),
+			Adapter: &v1beta1.AdapterTemplate{
+				Labels:      map[string]string{"team": "ci"},
+				Annotations: map[string]string{"sidecar.istio.io/inject": "false"},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+				},
+				SecurityContext:    &corev1.SecurityContext{RunAsNonRoot: ptr.To(true)},
+				PodSecurityContext: &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](1000)},
+				NodeSelector:       map[string]string{"kubernetes.io/os": "linux"},
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{
+							Weight: 1,
+							Preference: corev1.NodeSelectorTerm{
+								MatchExpressions: []corev1.NodeSelectorRequirement{{
+									Key:      "pool",
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{"gitlab"},
+								}},
+							},
+						}},
+					},
+				},
+				Tolerations: []corev1.Toleration{{
+					Key:      "dedicated",
+					Operator: corev1.TolerationOpExists,
+					Effect:   corev1.TaintEffectNoSchedule,
+				}},
+			},
+		},
+		Status: v1beta1.GitLabSourceStatus{
+			SourceStatus: duckv1.SourceStatus{
+				Status: duckv1.Status{
+					ObservedGeneration: 17,
+					Conditions: duckv1.Conditions{{
+						Type:   apis.ConditionReady,
+						Status: corev1.ConditionTrue,
+					}},
+				},
+				SinkURI: apis.HTTP("sink.example.com"),
+				CloudEventAttributes: []duckv1.CloudEventAttributes{{
+					Type:   "dev.knative.sources.gitlab.push",
+					Source: "https://gitlab.example.com/mygroup/myproject",
+				}},
+			},
+			Webhooks: []v1beta1.WebhookStatus{{
+				ProjectURL:         "https://gitlab.example.com/mygroup/myproject",
+				ID:                 1,
+				OwnerID:            10,
+				PathWithNamespace:  "mygroup/myproject",
+				URL:                "https://adapter.example.com",
+				EventTypes:         []string{v1beta1.GitLabWebhookIssues, v1beta1.GitLabWebhookPush},
+				SSLVerify:          true,
+				AlertStatus:        "temporarily_disabled",
+				DisabledUntil:      &metav1.Time{Time: time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)},
+				ObservedConfigHash: "0123456789abcdef",
+				SecretTokenHash:    "fedcba9876543210",
+			}, {
+				ProjectURL: "https://gitlab.example.com/mygroup/otherproject",
+				ID:         2,
+			}},
+			SecretTokenSecretName: "name-secret-token",
+			AccessTokenSecretName: "name-access-token",
+			ProvisionedAccessTokens: []v1beta1.ProvisionedAccessToken{{
+				ProjectURL: "https://gitlab.example.com/group/project",
+				ProjectID:  42,
+				TokenID:    7,
+				ExpiresAt:  metav1.Time{Time: time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)},
+			}},
+			AccessTokenExpiresAt: &metav1.Time{Time: time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)},
+			SecretTokenRotation: &v1beta1.SecretTokenRotationStatus{
+				PreviousSecretName: "name-applied-secret-token",
+				HooksUpdatedTime:   &metav1.Time{Time: time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)},
+			},
+		},
+	}
+}
@@ -18,12 +18,115 @@ package v1alpha1
 
 import (
 	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"knative.dev/pkg/apis"
 )
 
 func (g *GitLabSource) SetDefaults(ctx context.Context) {
+	ctx = apis.WithinParent(ctx, g.ObjectMeta)
 	g.Spec.SetDefaults(ctx)
 }
 
 func (gs *GitLabSourceSpec) SetDefaults(ctx context.Context) {
-	// Nothing yet.
+	gs.Sink.SetDefaults(ctx)
+
+	if gs.ProjectURL != "" {
+		gs.ProjectURL = canonicalGitLabURL(gs.ProjectURL, true)
+	}
+	if gs.GroupURL != "" {
+		gs.GroupURL = canonicalGitLabURL(gs.GroupURL, false)
+	}
+	if len(gs.ProjectURLs) > 0 {
+		projectURLs := make([]string, 0, len(gs.ProjectURLs))
+		seen := make(map[string]struct{}, len(gs.ProjectURLs))
+
+		for _, u := range gs.ProjectURLs {
+			u = canonicalGitLabURL(u, true)
+			if _, isDup := seen[u]; isDup {
+				continue
+			}
+			seen[u] = struct{}{}
+			projectURLs = append(projectURLs, u)
+		}
+		gs.ProjectURLs = projectURLs
+	}
+
+	if len(gs.EventTypes) > 0 {
+		gs.EventTypes = canonicalWebhookNames(gs.EventTypes)
+	}
+
+	if gs.PushEventsBranchFilter != "" && gs.BranchFilterStrategy == "" {
+		gs.BranchFilterStrategy = BranchFilterStrategyWildcard
+	}
+}
+
+// webhooksByEventType maps the types of events emitted by GitLab webhooks,
+// which users may use as friendly names for those webhooks, to the name of
+// the hook attribute enabling them.
+var webhooksByEventType = map[string]string{
+	"confidential_issue":               GitLabWebhookConfidentialIssues,
+	"confidential_note":                GitLabWebhookConfidentialNote,
+	GitLabEventTypeDeployment:          GitLabWebhookDeployment,
+	GitLabEventTypeFeatureFlag:         GitLabWebhookFeatureFlag,
+	GitLabEventTypeIssue:               GitLabWebhookIssues,
+	GitLabEventTypeJob:                 GitLabWebhookJob,
+	GitLabEventTypeMember:              GitLabWebhookMember,
+	GitLabEventTypeMergeRequest:        GitLabWebhookMergeRequests,
+	GitLabEventTypeNote:                GitLabWebhookNote,
+	GitLabEventTypePipeline:            GitLabWebhookPipeline,
+	GitLabEventTypePush:                GitLabWebhookPush,
+	GitLabEventTypeRelease:             GitLabWebhookReleases,
+	GitLabEventTypeResourceAccessToken: GitLabWebhookResourceAccessToken,
+	GitLabEventTypeSubgroup:            GitLabWebhookSubgroup,
+	GitLabEventTypeTagPush:             GitLabWebhookTagPush,
+	GitLabEventTypeWikiPage:            GitLabWebhookWikiPage,
+}
+
+// canonicalWebhookNames returns the given webhook names rewritten to the name
+// of the hook attributes enabling them, without duplicates, sorted in
+// increasing lexical order.
+func canonicalWebhookNames(names []string) []string {
+	uniqueNames := make(map[string]struct{}, len(names))
+
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if hook, isFriendly := webhooksByEventType[name]; isFriendly {
+			name = hook
+		}
+		uniqueNames[name] = struct{}{}
+	}
+
+	canonical := make([]string, 0, len(uniqueNames))
+	for name := range uniqueNames {
+		canonical = append(canonical, name)
+	}
+	sort.Strings(canonical)
+
+	return canonical
+}
+
+// canonicalGitLabURL returns the canonical form of the URL of a GitLab
+// instance, project or group, with a lowercase scheme and host, and without
+// trailing slash. The ".git" suffix of clone URLs is removed from project
+// URLs.
+// URLs which can not be parsed are returned unchanged, and rejected during
+// validation.
+func canonicalGitLabURL(rawURL string, isProject bool) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	if isProject {
+		u.Path = strings.TrimSuffix(u.Path, ".git")
+	}
+	u.RawPath = ""
+
+	return u.String()
 }
@@ -21,6 +21,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 func TestGitlabSourceDefaults(t *testing.T) {
@@ -34,6 +38,71 @@ func TestGitlabSourceDefaults(t *testing.T) {
 				Spec: GitLabSourceSpec{},
 			},
 		},
+		"canonical URLs": {
+			initial: GitLabSource{
+				Spec: GitLabSourceSpec{
+					ProjectURL: "HTTPS://GitLab.Example.com/MyGroup/MyProject.git/",
+					ProjectURLs: []string{
+						"https://gitlab.example.com/mygroup/otherproject/",
+						"https://gitlab.example.com/mygroup/otherproject.git",
+					},
+				},
+			},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{
+					ProjectURL:  "https://gitlab.example.com/MyGroup/MyProject",
+					ProjectURLs: []string{"https://gitlab.example.com/mygroup/otherproject"},
+				},
+			},
+		},
+		"friendly event names": {
+			initial: GitLabSource{
+				Spec: GitLabSourceSpec{
+					EventTypes: []string{"push", "merge_request", "push_events", "confidential_issue", "Issue"},
+				},
+			},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{
+					EventTypes: []string{
+						GitLabWebhookConfidentialIssues,
+						GitLabWebhookIssues,
+						GitLabWebhookMergeRequests,
+						GitLabWebhookPush,
+					},
+				},
+			},
+		},
+		"sink namespace": {
+			initial: GitLabSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "myns"},
+				Spec: GitLabSourceSpec{
+					SourceSpec: duckv1.SourceSpec{Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{Kind: "Service", Name: "mysvc"},
+					}},
+				},
+			},
+			expected: GitLabSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "myns"},
+				Spec: GitLabSourceSpec{
+					SourceSpec: duckv1.SourceSpec{Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{Kind: "Service", Name: "mysvc", Namespace: "myns"},
+					}},
+				},
+			},
+		},
+		"branch filter without strategy": {
+			initial: GitLabSource{
+				Spec: GitLabSourceSpec{
+					PushEventsBranchFilter: "release/*",
+				},
+			},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{
+					PushEventsBranchFilter: "release/*",
+					BranchFilterStrategy:   BranchFilterStrategyWildcard,
+				},
+			},
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
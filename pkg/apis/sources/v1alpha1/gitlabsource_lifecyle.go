@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"sort"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -40,6 +41,17 @@ const (
 	// GitLabSourceConditionDeployed has status True when the
 	// GitLabSource's receive adapter has been successfully deployed.
 	GitLabSourceConditionDeployed apis.ConditionType = "Deployed"
+
+	// GitLabSourceConditionAdapterUpToDate has status True when the
+	// source's receive adapter runs its latest desired configuration.
+	// It doesn't contribute to the readiness of the source.
+	GitLabSourceConditionAdapterUpToDate apis.ConditionType = "AdapterUpToDate"
+
+	// GitLabSourceConditionSecretsWatched has status True when all the
+	// Secrets referenced by the source carry the label which lets the
+	// controller watch them.
+	// It doesn't contribute to the readiness of the source.
+	GitLabSourceConditionSecretsWatched apis.ConditionType = "SecretsWatched"
 )
 
 var gitLabSourceCondSet = apis.NewLivingConditionSet(
@@ -108,33 +120,102 @@ const eventPrefixGitLab = "dev.knative.sources.gitlab."
 // payloads sent by GitLab's webhooks.
 // https://docs.gitlab.com/ee/user/project/integrations/webhooks.html#events
 const (
-	GitLabEventTypeBuild        = "build"
-	GitLabEventTypeDeployment   = "deployment"
-	GitLabEventTypeIssue        = "issue"
-	GitLabEventTypeMergeRequest = "merge_request"
-	GitLabEventTypeNote         = "note"
-	GitLabEventTypePipeline     = "pipeline"
-	GitLabEventTypePush         = "push"
-	GitLabEventTypeTagPush      = "tag_push"
-	GitLabEventTypeWikiPage     = "wiki_page"
+	GitLabEventTypeDeployment          = "deployment"
+	GitLabEventTypeFeatureFlag         = "feature_flag"
+	GitLabEventTypeIssue               = "issue"
+	GitLabEventTypeJob                 = "job"
+	GitLabEventTypeMember              = "member"
+	GitLabEventTypeMergeRequest        = "merge_request"
+	GitLabEventTypeNote                = "note"
+	GitLabEventTypePipeline            = "pipeline"
+	GitLabEventTypePush                = "push"
+	GitLabEventTypeRelease             = "release"
+	GitLabEventTypeResourceAccessToken = "resource_access_token"
+	GitLabEventTypeSubgroup            = "subgroup"
+	GitLabEventTypeTagPush             = "tag_push"
+	GitLabEventTypeWikiPage            = "wiki_page"
+
+	// Type of job events delivered by older GitLab versions.
+	GitLabEventTypeBuild = "build"
 )
 
-// Types of webhooks that can be enabled on a GitLab project.
+// Types of webhooks that can be enabled on a GitLab project or group.
 // https://docs.gitlab.com/ee/api/projects.html#add-project-hook
+// https://docs.gitlab.com/ee/api/groups.html#add-group-hook
 const (
-	GitLabWebhookConfidentialIssues = "confidential_issues_events"
-	GitLabWebhookConfidentialNote   = "confidential_note_events"
-	GitLabWebhookDeployment         = "deployment_events"
-	GitLabWebhookIssues             = "issues_events"
-	GitLabWebhookJob                = "job_events"
-	GitLabWebhookMergeRequests      = "merge_requests_events"
-	GitLabWebhookNote               = "note_events"
-	GitLabWebhookPipeline           = "pipeline_events"
-	GitLabWebhookPush               = "push_events"
-	GitLabWebhookTagPush            = "tag_push_events"
-	GitLabWebhookWikiPage           = "wiki_page_events"
+	GitLabWebhookConfidentialIssues  = "confidential_issues_events"
+	GitLabWebhookConfidentialNote    = "confidential_note_events"
+	GitLabWebhookDeployment          = "deployment_events"
+	GitLabWebhookFeatureFlag         = "feature_flag_events"
+	GitLabWebhookIssues              = "issues_events"
+	GitLabWebhookJob                 = "job_events"
+	GitLabWebhookMember              = "member_events"
+	GitLabWebhookMergeRequests       = "merge_requests_events"
+	GitLabWebhookNote                = "note_events"
+	GitLabWebhookPipeline            = "pipeline_events"
+	GitLabWebhookPush                = "push_events"
+	GitLabWebhookReleases            = "releases_events"
+	GitLabWebhookResourceAccessToken = "resource_access_token_events"
+	GitLabWebhookSubgroup            = "subgroup_events"
+	GitLabWebhookTagPush             = "tag_push_events"
+	GitLabWebhookWikiPage            = "wiki_page_events"
 )
 
+// eventTypesByWebhook maps the webhooks which can be enabled on a GitLab hook
+// to the type of event they emit.
+var eventTypesByWebhook = map[string]string{
+	GitLabWebhookConfidentialIssues:  GitLabEventTypeIssue,
+	GitLabWebhookConfidentialNote:    GitLabEventTypeNote,
+	GitLabWebhookDeployment:          GitLabEventTypeDeployment,
+	GitLabWebhookFeatureFlag:         GitLabEventTypeFeatureFlag,
+	GitLabWebhookIssues:              GitLabEventTypeIssue,
+	GitLabWebhookJob:                 GitLabEventTypeJob,
+	GitLabWebhookMember:              GitLabEventTypeMember,
+	GitLabWebhookMergeRequests:       GitLabEventTypeMergeRequest,
+	GitLabWebhookNote:                GitLabEventTypeNote,
+	GitLabWebhookPipeline:            GitLabEventTypePipeline,
+	GitLabWebhookPush:                GitLabEventTypePush,
+	GitLabWebhookReleases:            GitLabEventTypeRelease,
+	GitLabWebhookResourceAccessToken: GitLabEventTypeResourceAccessToken,
+	GitLabWebhookSubgroup:            GitLabEventTypeSubgroup,
+	GitLabWebhookTagPush:             GitLabEventTypeTagPush,
+	GitLabWebhookWikiPage:            GitLabEventTypeWikiPage,
+}
+
+// legacyEventTypesByWebhook maps webhooks to the type of event they also emit
+// for compatibility with earlier releases, so that the Triggers which filter
+// on that type keep matching.
+var legacyEventTypesByWebhook = map[string]string{
+	// Job events are emitted as build events when GitLab delivers them with
+	// the "Build Hook" header of older GitLab versions.
+	GitLabWebhookJob: GitLabEventTypeBuild,
+}
+
+// Types of webhooks that GitLab offers but which can not be enabled on the
+// hooks of sources.
+const (
+	GitLabWebhookEmoji         = "emoji_events"
+	GitLabWebhookVulnerability = "vulnerability_events"
+)
+
+// unsupportedWebhooks maps the webhooks which can not be enabled on the hooks
+// of sources to the reason why.
+var unsupportedWebhooks = map[string]string{
+	// The GitLab client neither enables emoji events on project hooks nor
+	// parses their payloads, which receive adapters would therefore reject.
+	GitLabWebhookEmoji: "emoji events can not be parsed by receive adapters",
+	// Vulnerability events are unknown to the GitLab client.
+	GitLabWebhookVulnerability: "vulnerability events can not be parsed by receive adapters",
+}
+
+// groupOnlyWebhooks are the webhooks which can only be enabled on the hooks
+// of GitLab groups.
+var groupOnlyWebhooks = map[string]struct{}{
+	GitLabWebhookFeatureFlag: {},
+	GitLabWebhookMember:      {},
+	GitLabWebhookSubgroup:    {},
+}
+
 // GitLabEventType returns a GitLab event type in a format suitable for usage
 // as a CloudEvent type attribute.
 func GitLabEventType(eventType string) string {
@@ -143,13 +224,25 @@ func GitLabEventType(eventType string) string {
 
 // EventTypes returns the types of events emitted by the source, sorted in
 // increasing lexical order.
+// Webhooks which can not be enabled on the source's hooks are ignored.
 func (s *GitLabSource) EventTypes() []string {
 	// Some webhooks emit the same event type, so we use a map as an
 	// intermediate store to avoid duplicates in the returned slice.
 	uniqueTypes := make(map[string]struct{}, len(s.Spec.EventTypes))
 
+	unsupported := make(map[string]struct{})
+	for _, hook := range s.UnsupportedEventTypes() {
+		unsupported[hook] = struct{}{}
+	}
+
 	for _, hook := range s.Spec.EventTypes {
-		uniqueTypes[eventTypeForWebhook(hook)] = struct{}{}
+		if _, isUnsupported := unsupported[hook]; isUnsupported {
+			continue
+		}
+		uniqueTypes[eventTypesByWebhook[hook]] = struct{}{}
+		if legacyType, hasLegacyType := legacyEventTypesByWebhook[hook]; hasLegacyType {
+			uniqueTypes[legacyType] = struct{}{}
+		}
 	}
 
 	types := make([]string, 0, len(uniqueTypes))
@@ -162,28 +255,132 @@ func (s *GitLabSource) EventTypes() []string {
 	return types
 }
 
-// eventTypeForWebhook returns the type of event emitted by a given GitLab
-// webhook.
-func eventTypeForWebhook(webhookName string) string {
-	eventTypesByWebhook := map[string]string{
-		GitLabWebhookConfidentialIssues: GitLabEventTypeIssue,
-		GitLabWebhookConfidentialNote:   GitLabEventTypeNote,
-		GitLabWebhookDeployment:         GitLabEventTypeDeployment,
-		GitLabWebhookIssues:             GitLabEventTypeIssue,
-		GitLabWebhookJob:                GitLabEventTypeBuild,
-		GitLabWebhookMergeRequests:      GitLabEventTypeMergeRequest,
-		GitLabWebhookNote:               GitLabEventTypeNote,
-		GitLabWebhookPipeline:           GitLabEventTypePipeline,
-		GitLabWebhookPush:               GitLabEventTypePush,
-		GitLabWebhookTagPush:            GitLabEventTypeTagPush,
-		GitLabWebhookWikiPage:           GitLabEventTypeWikiPage,
+// UnsupportedEventTypes returns the webhooks from the source's spec which can
+// not be enabled on the source's hooks, either because they are unknown, or
+// because they are only available on groups and the source isn't a group
+// source.
+func (s *GitLabSource) UnsupportedEventTypes() []string {
+	var unsupported []string
+
+	for _, hook := range s.Spec.EventTypes {
+		if _, isKnown := eventTypesByWebhook[hook]; !isKnown {
+			unsupported = append(unsupported, hook)
+			continue
+		}
+		if _, isGroupOnly := groupOnlyWebhooks[hook]; isGroupOnly && !s.IsGroupSource() {
+			unsupported = append(unsupported, hook)
+		}
+	}
+
+	return unsupported
+}
+
+// IsGroupSource returns whether the source receives events from a GitLab group
+// instead of a single GitLab project.
+func (s *GitLabSource) IsGroupSource() bool {
+	return s.Spec.GroupURL != ""
+}
+
+// Projects returns the URLs of the GitLab projects the source receives events
+// from, without duplicates, in the order in which they are declared.
+func (s *GitLabSource) Projects() []string {
+	projects := make([]string, 0, len(s.Spec.ProjectURLs)+1)
+	seen := make(map[string]struct{}, len(s.Spec.ProjectURLs)+1)
+
+	for _, u := range append([]string{s.Spec.ProjectURL}, s.Spec.ProjectURLs...) {
+		if _, isDup := seen[u]; isDup || u == "" {
+			continue
+		}
+		seen[u] = struct{}{}
+		projects = append(projects, u)
+	}
+
+	return projects
+}
+
+// IsMultiProjectSource returns whether the source may receive events from more
+// than one GitLab project, either from a group or from a list of projects.
+func (s *GitLabSource) IsMultiProjectSource() bool {
+	return s.IsGroupSource() || len(s.Projects()) > 1
+}
+
+// WebhookTargets returns the URLs of the GitLab projects or group which a
+// hook must be registered with.
+func (s *GitLabSource) WebhookTargets() []string {
+	if s.IsGroupSource() {
+		return []string{s.Spec.GroupURL}
 	}
+	return s.Projects()
+}
 
-	return eventTypesByWebhook[webhookName]
+// NewWebhookStatus returns the status of a hook registered with the given
+// GitLab project or group.
+func (s *GitLabSource) NewWebhookStatus(target string, hookID int) WebhookStatus {
+	if s.IsGroupSource() {
+		return WebhookStatus{GroupURL: target, ID: hookID}
+	}
+	return WebhookStatus{ProjectURL: target, ID: hookID}
+}
+
+// Target returns the URL of the GitLab project or group the hook is
+// registered with.
+func (w *WebhookStatus) Target() string {
+	if w.GroupURL != "" {
+		return w.GroupURL
+	}
+	return w.ProjectURL
+}
+
+// MigrateDeprecatedStatus moves the value of the deprecated WebhookID status
+// attribute to the list of Webhooks.
+func (s *GitLabSource) MigrateDeprecatedStatus() {
+	if s.Status.WebhookID == nil {
+		return
+	}
+
+	if len(s.Status.Webhooks) == 0 {
+		target := s.Spec.GroupURL
+		if target == "" {
+			target = s.Spec.ProjectURL
+		}
+		s.Status.Webhooks = []WebhookStatus{s.NewWebhookStatus(target, *s.Status.WebhookID)}
+	}
+
+	s.Status.WebhookID = nil
+}
+
+// GeneratedSecretTokenKey is the key of the secret token inside Secrets
+// generated by the controller.
+const GeneratedSecretTokenKey = "secretToken"
+
+// SecretTokenRef returns a reference to the secret token used by the source's
+// hooks: either the one declared in the spec, or the one generated by the
+// controller. Returns nil if no secret token was generated yet.
+func (s *GitLabSource) SecretTokenRef() *corev1.SecretKeySelector {
+	if s.Spec.SecretToken != nil {
+		return s.Spec.SecretToken.SecretKeyRef
+	}
+
+	if s.Status.SecretTokenSecretName == "" {
+		return nil
+	}
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: s.Status.SecretTokenSecretName,
+		},
+		Key: GeneratedSecretTokenKey,
+	}
 }
 
 // AsEventSource returns a unique reference to the source suitable for use as a
 // CloudEvent source attribute.
+// Group sources and sources with multiple projects emit events with the URL of
+// the originating project as source attribute whenever the payload carries
+// it, and fall back to the URL of the group or first project otherwise.
 func (s *GitLabSource) AsEventSource() string {
-	return s.Spec.ProjectURL
+	if targets := s.WebhookTargets(); len(targets) > 0 {
+		return targets[0]
+	}
+	return ""
 }
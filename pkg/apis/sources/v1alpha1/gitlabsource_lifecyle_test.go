@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestEventTypes(t *testing.T) {
@@ -29,10 +31,13 @@ func TestEventTypes(t *testing.T) {
 		GitLabWebhookPush,               // repeat a previous item
 		GitLabWebhookConfidentialIssues, // / pick webhooks that emit...
 		GitLabWebhookIssues,             // \ ...the same event type ("issue")
+		GitLabWebhookJob,                // "job", and the legacy "build"
 	}
 
 	expectTypes := []string{ // sorted
+		"dev.knative.sources.gitlab.build",
 		"dev.knative.sources.gitlab.issue",
+		"dev.knative.sources.gitlab.job",
 		"dev.knative.sources.gitlab.merge_request",
 		"dev.knative.sources.gitlab.push",
 	}
@@ -45,3 +50,107 @@ func TestEventTypes(t *testing.T) {
 
 	assert.Equal(t, expectTypes, testSrc.EventTypes())
 }
+
+func TestAsEventSource(t *testing.T) {
+	const (
+		projectURL = "https://gitlab.example.com/mygroup/myproject"
+		groupURL   = "https://gitlab.example.com/mygroup"
+	)
+
+	projectSrc := &GitLabSource{Spec: GitLabSourceSpec{ProjectURL: projectURL}}
+	assert.False(t, projectSrc.IsGroupSource())
+	assert.Equal(t, projectURL, projectSrc.AsEventSource())
+
+	groupSrc := &GitLabSource{Spec: GitLabSourceSpec{GroupURL: groupURL}}
+	assert.True(t, groupSrc.IsGroupSource())
+	assert.Equal(t, groupURL, groupSrc.AsEventSource())
+}
+
+func TestWebhookTargets(t *testing.T) {
+	const (
+		project1URL = "https://gitlab.example.com/mygroup/project1"
+		project2URL = "https://gitlab.example.com/mygroup/project2"
+		groupURL    = "https://gitlab.example.com/mygroup"
+	)
+
+	singleSrc := &GitLabSource{Spec: GitLabSourceSpec{ProjectURL: project1URL}}
+	assert.Equal(t, []string{project1URL}, singleSrc.WebhookTargets())
+	assert.False(t, singleSrc.IsMultiProjectSource())
+
+	multiSrc := &GitLabSource{Spec: GitLabSourceSpec{
+		ProjectURL:  project2URL,
+		ProjectURLs: []string{project1URL, project2URL},
+	}}
+	assert.Equal(t, []string{project2URL, project1URL}, multiSrc.WebhookTargets())
+	assert.True(t, multiSrc.IsMultiProjectSource())
+	assert.Equal(t, project2URL, multiSrc.AsEventSource())
+
+	groupSrc := &GitLabSource{Spec: GitLabSourceSpec{GroupURL: groupURL}}
+	assert.Equal(t, []string{groupURL}, groupSrc.WebhookTargets())
+	assert.True(t, groupSrc.IsMultiProjectSource())
+	assert.Equal(t, WebhookStatus{GroupURL: groupURL, ID: 1}, groupSrc.NewWebhookStatus(groupURL, 1))
+}
+
+func TestMigrateDeprecatedStatus(t *testing.T) {
+	const projectURL = "https://gitlab.example.com/mygroup/myproject"
+
+	hookID := 42
+
+	src := &GitLabSource{
+		Spec:   GitLabSourceSpec{ProjectURL: projectURL},
+		Status: GitLabSourceStatus{WebhookID: &hookID},
+	}
+
+	src.MigrateDeprecatedStatus()
+
+	assert.Nil(t, src.Status.WebhookID)
+	assert.Equal(t, []WebhookStatus{{ProjectURL: projectURL, ID: hookID}}, src.Status.Webhooks)
+}
+
+func TestSecretTokenRef(t *testing.T) {
+	src := &GitLabSource{}
+	assert.Nil(t, src.SecretTokenRef())
+
+	src.Status.SecretTokenSecretName = "generated"
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "generated"},
+		Key:                  GeneratedSecretTokenKey,
+	}, src.SecretTokenRef())
+
+	specRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+		Key:                  "secretToken",
+	}
+	src.Spec.SecretToken = &SecretValueFromSource{SecretKeyRef: specRef}
+	assert.Equal(t, specRef, src.SecretTokenRef())
+}
+
+func TestUnsupportedEventTypes(t *testing.T) {
+	definedWebhooks := []string{
+		GitLabWebhookPush,
+		GitLabWebhookReleases,
+		GitLabWebhookMember, // only available on groups
+		GitLabWebhookEmoji,  // not supported
+	}
+
+	projectSrc := &GitLabSource{Spec: GitLabSourceSpec{
+		ProjectURL: "https://gitlab.example.com/mygroup/myproject",
+		EventTypes: definedWebhooks,
+	}}
+	assert.Equal(t, []string{GitLabWebhookMember, GitLabWebhookEmoji}, projectSrc.UnsupportedEventTypes())
+	assert.Equal(t, []string{
+		"dev.knative.sources.gitlab.push",
+		"dev.knative.sources.gitlab.release",
+	}, projectSrc.EventTypes())
+
+	groupSrc := &GitLabSource{Spec: GitLabSourceSpec{
+		GroupURL:   "https://gitlab.example.com/mygroup",
+		EventTypes: definedWebhooks,
+	}}
+	assert.Equal(t, []string{GitLabWebhookEmoji}, groupSrc.UnsupportedEventTypes())
+	assert.Equal(t, []string{
+		"dev.knative.sources.gitlab.member",
+		"dev.knative.sources.gitlab.push",
+		"dev.knative.sources.gitlab.release",
+	}, groupSrc.EventTypes())
+}
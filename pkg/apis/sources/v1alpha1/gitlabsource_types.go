@@ -28,6 +28,7 @@ import (
 var (
 	_ apis.Validatable   = (*GitLabSource)(nil)
 	_ apis.Defaultable   = (*GitLabSource)(nil)
+	_ apis.Convertible   = (*GitLabSource)(nil)
 	_ kmeta.OwnerRefable = (*GitLabSource)(nil)
 	_ duckv1.KRShaped    = (*GitLabSource)(nil)
 )
@@ -46,14 +47,33 @@ type GitLabSourceSpec struct {
 
 	// ProjectURL is the url of the GitLab project for which we are interested
 	// to receive events from.
+	// Mutually exclusive with GroupURL.
 	// Examples:
 	//   https://gitlab.com/gitlab-org/gitlab-foss
-	// +kubebuilder:validation:MinLength=1
-	ProjectURL string `json:"projectUrl"`
+	// +optional
+	ProjectURL string `json:"projectUrl,omitempty"`
+
+	// ProjectURLs is a list of urls of GitLab projects for which we are
+	// interested to receive events from, in addition to ProjectURL. A hook
+	// is registered with each project, and the events of all projects are
+	// delivered through a single receive adapter.
+	// Mutually exclusive with GroupURL.
+	// +optional
+	ProjectURLs []string `json:"projectUrls,omitempty"`
 
-	// List of webhooks to enable on the selected GitLab project.
+	// GroupURL is the url of the GitLab group for which we are interested
+	// to receive events from. A single group hook is registered, which
+	// delivers events for every project contained in the group.
+	// Mutually exclusive with ProjectURL and ProjectURLs.
+	// Examples:
+	//   https://gitlab.com/gitlab-org
+	// +optional
+	GroupURL string `json:"groupUrl,omitempty"`
+
+	// List of webhooks to enable on the selected GitLab project or group.
 	// Those correspond to the attributes enumerated at
-	// https://docs.gitlab.com/ee/api/projects.html#add-project-hook
+	// https://docs.gitlab.com/ee/api/projects.html#add-project-hook and
+	// https://docs.gitlab.com/ee/api/groups.html#add-group-hook
 	EventTypes []string `json:"eventTypes"`
 
 	// AccessToken is the Kubernetes secret containing the GitLab
@@ -61,13 +81,38 @@ type GitLabSourceSpec struct {
 	AccessToken SecretValueFromSource `json:"accessToken"`
 
 	// SecretToken is the Kubernetes secret containing the GitLab
-	// secret token
-	SecretToken SecretValueFromSource `json:"secretToken"`
+	// secret token. When omitted, the controller generates a random token
+	// and stores it in a Secret owned by the source.
+	// +optional
+	SecretToken *SecretValueFromSource `json:"secretToken,omitempty"`
 
 	// SSLVerify if true configure webhook so the ssl verification is done when triggering the hook
 	SSLVerify bool `json:"sslverify,omitempty"`
+
+	// PushEventsBranchFilter restricts the delivery of push events to
+	// branches which match the filter. The filter is interpreted according
+	// to BranchFilterStrategy.
+	// Examples:
+	//   main
+	//   release/*
+	// +optional
+	PushEventsBranchFilter string `json:"pushEventsBranchFilter,omitempty"`
+
+	// BranchFilterStrategy is the strategy used by GitLab to match branches
+	// against PushEventsBranchFilter. One of "wildcard", "regex" or
+	// "all_branches". Defaults to "wildcard" when a filter is set.
+	// +optional
+	BranchFilterStrategy string `json:"branchFilterStrategy,omitempty"`
 }
 
+// Strategies used by GitLab to filter the branches of push events.
+// https://docs.gitlab.com/ee/user/project/integrations/webhooks.html#filter-push-events-by-branch
+const (
+	BranchFilterStrategyWildcard    = "wildcard"
+	BranchFilterStrategyRegex       = "regex"
+	BranchFilterStrategyAllBranches = "all_branches"
+)
+
 // SecretValueFromSource represents the source of a secret value
 type SecretValueFromSource struct {
 	// The Secret key to select from.
@@ -85,12 +130,39 @@ type GitLabSourceStatus struct {
 	//   Source.
 	duckv1.SourceStatus `json:",inline"`
 
-	// WebhookID of the project hook registered with GitLab
+	// Webhooks registered with GitLab, one per project or group.
+	// +optional
+	Webhooks []WebhookStatus `json:"webhooks,omitempty"`
+
+	// SecretTokenSecretName is the name of the Secret containing the
+	// secret token generated by the controller, when the spec doesn't
+	// reference one.
+	// +optional
+	SecretTokenSecretName string `json:"secretTokenSecretName,omitempty"`
+
+	// WebhookID of the project or group hook registered with GitLab.
+	// Deprecated: superseded by Webhooks, which WebhookID gets migrated to.
+	// +optional
 	WebhookID *int `json:"webhookID,omitempty"`
 }
 
+// WebhookStatus describes a hook registered with a GitLab project or group.
+type WebhookStatus struct {
+	// ProjectURL is the url of the GitLab project the hook is registered
+	// with. Mutually exclusive with GroupURL.
+	// +optional
+	ProjectURL string `json:"projectUrl,omitempty"`
+
+	// GroupURL is the url of the GitLab group the hook is registered with.
+	// Mutually exclusive with ProjectURL.
+	// +optional
+	GroupURL string `json:"groupUrl,omitempty"`
+
+	// ID of the hook.
+	ID int `json:"id"`
+}
+
 // +genclient
-// +genreconciler
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // GitLabSource is the Schema for the gitlabsources API.
@@ -18,13 +18,23 @@ package v1alpha1
 
 import (
 	"context"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"knative.dev/pkg/apis"
 )
 
 // Validate GitLab source object fields
 func (s *GitLabSource) Validate(ctx context.Context) *apis.FieldError {
-	return s.Spec.Validate(ctx).ViaField("spec")
+	errs := s.Spec.Validate(ctx).ViaField("spec")
+
+	if apis.IsInUpdate(ctx) {
+		original := apis.GetBaseline(ctx).(*GitLabSource)
+		errs = errs.Also(s.Spec.validateUpdate(&original.Spec).ViaField("spec"))
+	}
+
+	return errs
 }
 
 // Validate GitLab source Spec object fields
@@ -36,5 +46,177 @@ func (s *GitLabSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(fieldErr.ViaField("sink"))
 	}
 
+	// Validate event origin
+	if s.GroupURL != "" {
+		if s.ProjectURL != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf("groupUrl", "projectUrl"))
+		}
+		if len(s.ProjectURLs) > 0 {
+			errs = errs.Also(apis.ErrMultipleOneOf("groupUrl", "projectUrls"))
+		}
+		errs = errs.Also(validateGitLabURL(s.GroupURL, false).ViaField("groupUrl"))
+
+	} else {
+		if s.ProjectURL == "" && len(s.ProjectURLs) == 0 {
+			errs = errs.Also(apis.ErrMissingOneOf("projectUrl", "projectUrls", "groupUrl"))
+		}
+		if s.ProjectURL != "" {
+			errs = errs.Also(validateGitLabURL(s.ProjectURL, true).ViaField("projectUrl"))
+		}
+		for i, u := range s.ProjectURLs {
+			errs = errs.Also(validateGitLabURL(u, true).ViaFieldIndex("projectUrls", i))
+		}
+	}
+
+	// All projects must be hosted on the same GitLab instance, since they
+	// are accessed with the same API token
+	if targets := s.webhookTargets(); len(targets) > 1 {
+		instance := gitLabInstance(targets[0])
+		for i, u := range s.ProjectURLs {
+			if inst := gitLabInstance(u); inst != "" && instance != "" && inst != instance {
+				errs = errs.Also(apis.ErrInvalidValue(u, apis.CurrentField,
+					"all projects must be hosted on the same GitLab instance").ViaFieldIndex("projectUrls", i))
+			}
+		}
+	}
+
+	// Validate webhooks
+	if len(s.EventTypes) == 0 {
+		errs = errs.Also(apis.ErrMissingField("eventTypes"))
+	}
+	for i, hook := range s.EventTypes {
+		if reason, isUnsupported := unsupportedWebhooks[hook]; isUnsupported {
+			errs = errs.Also(apis.ErrInvalidValue(hook, apis.CurrentField,
+				"not supported, "+reason).ViaFieldIndex("eventTypes", i))
+			continue
+		}
+		if _, isKnown := eventTypesByWebhook[hook]; !isKnown {
+			errs = errs.Also(apis.ErrInvalidArrayValue(hook, "eventTypes", i))
+			continue
+		}
+		if _, isGroupOnly := groupOnlyWebhooks[hook]; isGroupOnly && s.GroupURL == "" {
+			errs = errs.Also(apis.ErrInvalidValue(hook, apis.CurrentField,
+				"only available on group sources").ViaFieldIndex("eventTypes", i))
+		}
+	}
+
+	// Validate secrets
+	errs = errs.Also(s.AccessToken.Validate(ctx).ViaField("accessToken"))
+	if s.SecretToken != nil {
+		errs = errs.Also(s.SecretToken.Validate(ctx).ViaField("secretToken"))
+	}
+
+	// Validate push events branch filter
+	switch s.BranchFilterStrategy {
+	case "", BranchFilterStrategyWildcard, BranchFilterStrategyAllBranches:
+	case BranchFilterStrategyRegex:
+		if _, err := regexp.Compile(s.PushEventsBranchFilter); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(s.PushEventsBranchFilter, "pushEventsBranchFilter", err.Error()))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.BranchFilterStrategy, "branchFilterStrategy"))
+	}
+
+	return errs
+}
+
+// validateUpdate validates the changes applied to the given original spec.
+func (s *GitLabSourceSpec) validateUpdate(original *GitLabSourceSpec) *apis.FieldError {
+	// Existing hooks are deleted using the API token of the updated spec, so
+	// moving the source to a different GitLab instance would orphan them.
+	origTargets, targets := original.webhookTargets(), s.webhookTargets()
+	if len(origTargets) == 0 || len(targets) == 0 {
+		return nil
+	}
+
+	if origInst, inst := gitLabInstance(origTargets[0]), gitLabInstance(targets[0]); origInst != inst {
+		return &apis.FieldError{
+			Message: "Immutable fields changed (-old +new)",
+			Paths:   []string{"projectUrl", "projectUrls", "groupUrl"},
+			Details: "the GitLab instance of the source can not be changed: -" + origInst + " +" + inst,
+		}
+	}
+
+	return nil
+}
+
+// webhookTargets returns the URLs of the GitLab projects or group declared in
+// the spec.
+func (s *GitLabSourceSpec) webhookTargets() []string {
+	return (&GitLabSource{Spec: *s}).WebhookTargets()
+}
+
+// Validate the reference to a secret value.
+func (s *SecretValueFromSource) Validate(ctx context.Context) *apis.FieldError {
+	if s.SecretKeyRef == nil {
+		return apis.ErrMissingField("secretKeyRef")
+	}
+
+	var errs *apis.FieldError
+	if s.SecretKeyRef.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKeyRef.name"))
+	}
+	if s.SecretKeyRef.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKeyRef.key"))
+	}
 	return errs
 }
+
+// validateGitLabURL validates the URL of a GitLab project or group.
+// A project URL must contain at least the path of a namespace and the name of
+// the project, e.g. "https://gitlab.example.com/mygroup/myproject".
+func validateGitLabURL(rawURL string, isProject bool) *apis.FieldError {
+	u, errs := parseGitLabURL(rawURL)
+	if errs != nil {
+		return errs
+	}
+
+	minSegments, kind := 1, "group"
+	if isProject {
+		minSegments, kind = 2, "project"
+	}
+
+	segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(segments) < minSegments {
+		return apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL must contain the path of a "+kind)
+	}
+	for _, seg := range segments {
+		if seg == "" {
+			return apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL must contain the path of a "+kind)
+		}
+	}
+
+	return nil
+}
+
+// parseGitLabURL parses the URL of a GitLab instance or of one of its
+// resources, and validates the components which are common to all those URLs.
+func parseGitLabURL(rawURL string) (*url.URL, *apis.FieldError) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField, err.Error())
+	}
+
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL scheme must be http or https")
+	case u.Host == "":
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL must contain a host")
+	case u.User != nil || u.RawQuery != "" || u.Fragment != "":
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField,
+			"the URL must not contain any user information, query or fragment")
+	}
+
+	return u, nil
+}
+
+// gitLabInstance returns the scheme and host of the GitLab instance hosting
+// the project or group with the given URL, or an empty string if the URL is
+// invalid.
+func gitLabInstance(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
@@ -22,34 +22,202 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"knative.dev/pkg/apis"
-	"knative.dev/pkg/webhook/resourcesemantics"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 func TestGitLabSourceValidation(t *testing.T) {
 	testCases := map[string]struct {
-		cr   resourcesemantics.GenericCRD
+		spec func(*GitLabSourceSpec)
 		want *apis.FieldError
 	}{
+		"valid": {
+			spec: func(*GitLabSourceSpec) {},
+		},
 		"empty sink": {
-			cr: &GitLabSource{
-				Spec: GitLabSourceSpec{},
+			spec: func(s *GitLabSourceSpec) {
+				s.Sink = duckv1.Destination{}
+			},
+			want: apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("spec.sink"),
+		},
+		"no project or group": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+			},
+			want: apis.ErrMissingOneOf("projectUrl", "projectUrls", "groupUrl").ViaField("spec"),
+		},
+		"group and projects": {
+			spec: func(s *GitLabSourceSpec) {
+				s.GroupURL = "https://gitlab.example.com/mygroup"
+				s.ProjectURL = ""
+				s.ProjectURLs = []string{"https://gitlab.example.com/mygroup/myproject"}
+			},
+			want: apis.ErrMultipleOneOf("groupUrl", "projectUrls").ViaField("spec"),
+		},
+		"project URL without scheme": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = "gitlab.example.com/mygroup/myproject"
+			},
+			want: apis.ErrInvalidValue("gitlab.example.com/mygroup/myproject", "spec.projectUrl",
+				"the URL scheme must be http or https"),
+		},
+		"project URL without host": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = "https:///mygroup/myproject"
+			},
+			want: apis.ErrInvalidValue("https:///mygroup/myproject", "spec.projectUrl",
+				"the URL must contain a host"),
+		},
+		"project URL without project path": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURLs = []string{"https://gitlab.example.com/mygroup"}
+			},
+			want: apis.ErrInvalidValue("https://gitlab.example.com/mygroup", "spec.projectUrls[0]",
+				"the URL must contain the path of a project"),
+		},
+		"projects on different instances": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURLs = []string{"https://gitlab.com/mygroup/myproject"}
+			},
+			want: apis.ErrInvalidValue("https://gitlab.com/mygroup/myproject", "spec.projectUrls[0]",
+				"all projects must be hosted on the same GitLab instance"),
+		},
+		"group URL without group path": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+				s.GroupURL = "https://gitlab.example.com"
+			},
+			want: apis.ErrInvalidValue("https://gitlab.example.com", "spec.groupUrl",
+				"the URL must contain the path of a group"),
+		},
+		"no event type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = nil
+			},
+			want: apis.ErrMissingField("spec.eventTypes"),
+		},
+		"unknown event type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = []string{GitLabWebhookPush, "unknown_events"}
+			},
+			want: apis.ErrInvalidArrayValue("unknown_events", "spec.eventTypes", 1),
+		},
+		"unsupported event type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = []string{GitLabWebhookPush, GitLabWebhookVulnerability}
+			},
+			want: apis.ErrInvalidValue(GitLabWebhookVulnerability, "spec.eventTypes[1]",
+				"not supported, vulnerability events can not be parsed by receive adapters"),
+		},
+		"group event type on project": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = []string{GitLabWebhookMember}
+			},
+			want: apis.ErrInvalidValue(GitLabWebhookMember, "spec.eventTypes[0]", "only available on group sources"),
+		},
+		"incomplete secret refs": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AccessToken.SecretKeyRef = nil
+				s.SecretToken.SecretKeyRef.Key = ""
+			},
+			want: apis.ErrMissingField("spec.accessToken.secretKeyRef", "spec.secretToken.secretKeyRef.key"),
+		},
+		"generated secret token": {
+			spec: func(s *GitLabSourceSpec) {
+				s.SecretToken = nil
+			},
+		},
+		"unknown branch filter strategy": {
+			spec: func(s *GitLabSourceSpec) {
+				s.BranchFilterStrategy = "glob"
+			},
+			want: apis.ErrInvalidValue("glob", "spec.branchFilterStrategy"),
+		},
+		"invalid branch filter regex": {
+			spec: func(s *GitLabSourceSpec) {
+				s.PushEventsBranchFilter = "release/("
+				s.BranchFilterStrategy = BranchFilterStrategyRegex
 			},
-			want: func() *apis.FieldError {
-				var errs *apis.FieldError
-				fe := apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("spec.sink")
-				errs = errs.Also(fe)
-				return errs
-			}(),
+			want: apis.ErrInvalidValue("release/(", "spec.pushEventsBranchFilter",
+				"error parsing regexp: missing closing ): `release/(`"),
 		},
 	}
 
-	for n, test := range testCases {
+	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
-			got := test.cr.Validate(context.Background())
-			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+			src := newValidGitLabSource()
+			tc.spec(&src.Spec)
+
+			got := src.Validate(context.Background())
+			if diff := cmp.Diff(tc.want.Error(), got.Error()); diff != "" {
 				t.Errorf("%s: validate (-want, +got) = %v", n, diff)
 			}
 		})
 	}
 }
+
+func TestGitLabSourceUpdateValidation(t *testing.T) {
+	testCases := map[string]struct {
+		spec    func(*GitLabSourceSpec)
+		wantErr bool
+	}{
+		"project added": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURLs = []string{"https://gitlab.example.com/mygroup/otherproject"}
+			},
+		},
+		"project replaced by group": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+				s.GroupURL = "https://gitlab.example.com/mygroup"
+			},
+		},
+		"instance changed": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = "https://gitlab.com/mygroup/myproject"
+			},
+			wantErr: true,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			orig := newValidGitLabSource()
+			src := newValidGitLabSource()
+			tc.spec(&src.Spec)
+
+			ctx := apis.WithinUpdate(context.Background(), orig)
+
+			if got := src.Validate(ctx); (got != nil) != tc.wantErr {
+				t.Errorf("Unexpected validation result: %v", got)
+			}
+		})
+	}
+}
+
+// newValidGitLabSource returns a GitLabSource with a valid spec.
+func newValidGitLabSource() *GitLabSource {
+	return &GitLabSource{
+		Spec: GitLabSourceSpec{
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{URI: apis.HTTP("sink.example.com")},
+			},
+			ProjectURL: "https://gitlab.example.com/mygroup/myproject",
+			EventTypes: []string{GitLabWebhookPush, GitLabWebhookIssues},
+			AccessToken: SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "accessToken",
+				},
+			},
+			SecretToken: &SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "secretToken",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// GitLabSystemSource reports the same conditions as GitLabSource.
+var gitLabSystemSourceCondSet = apis.NewLivingConditionSet(
+	GitLabSourceConditionSinkProvided,
+	GitLabSourceConditionDeployed,
+	GitLabSourceConditionWebhookConfigured,
+)
+
+// GetGroupVersionKind returns a GitLabSystemSource GVK. Implements the kmeta.OwnerRefable interface.
+func (*GitLabSystemSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("GitLabSystemSource")
+}
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*GitLabSystemSource) GetConditionSet() apis.ConditionSet {
+	return gitLabSystemSourceCondSet
+}
+
+// GetStatus retrieves the duck status for this resource. Implements the KRShaped interface.
+func (g *GitLabSystemSource) GetStatus() *duckv1.Status {
+	return &g.Status.Status
+}
+
+// MarkSink sets the SinkProvided condition to True using the given URI.
+func (s *GitLabSystemSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri == nil {
+		gitLabSystemSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionSinkProvided,
+			"EmptySinkURI", "The sink has no URI")
+		return
+	}
+	gitLabSystemSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionSinkProvided)
+}
+
+// MarkNoSink sets the SinkProvided condition to False.
+func (s *GitLabSystemSourceStatus) MarkNoSink() {
+	gitLabSystemSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionSinkProvided,
+		"SinkNotFound", "The sink does not exist or its URI is not set")
+}
+
+// MarkWebhook sets the WebhookConfigured condition to True.
+func (s *GitLabSystemSourceStatus) MarkWebhook() {
+	gitLabSystemSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionWebhookConfigured)
+}
+
+// MarkNoWebhook sets the WebhookConfigured condition to False with the given reason and message.
+func (s *GitLabSystemSourceStatus) MarkNoWebhook(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSystemSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionWebhookConfigured, reason, messageFormat, messageA...)
+}
+
+// MarkDeployed sets the Deployed condition to True.
+func (s *GitLabSystemSourceStatus) MarkDeployed() {
+	gitLabSystemSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionDeployed)
+}
+
+// MarkNotDeployed sets the Deployed condition to False with the given reason and message.
+func (s *GitLabSystemSourceStatus) MarkNotDeployed(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSystemSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionDeployed, reason, messageFormat, messageA...)
+}
+
+// MarkAdapterUpToDate sets the AdapterUpToDate condition to True.
+func (s *GitLabSystemSourceStatus) MarkAdapterUpToDate() {
+	gitLabSystemSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionAdapterUpToDate)
+}
+
+// MarkAdapterOutOfDate sets the AdapterUpToDate condition to False with the given message.
+func (s *GitLabSystemSourceStatus) MarkAdapterOutOfDate(messageFormat string, messageA ...interface{}) {
+	gitLabSystemSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionAdapterUpToDate,
+		"AdapterOutOfDate", messageFormat, messageA...)
+}
+
+// MarkSecretsWatched sets the SecretsWatched condition to True.
+func (s *GitLabSystemSourceStatus) MarkSecretsWatched() {
+	gitLabSystemSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionSecretsWatched)
+}
+
+// MarkSecretsNotWatched sets the SecretsWatched condition to False with the given message.
+func (s *GitLabSystemSourceStatus) MarkSecretsNotWatched(messageFormat string, messageA ...interface{}) {
+	gitLabSystemSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionSecretsWatched,
+		"SecretNotLabeled", messageFormat, messageA...)
+}
+
+// String prepended to GitLab system event types to make them fully-qualified.
+const eventPrefixGitLabSystem = eventPrefixGitLab + "system."
+
+// Types of events emitted by a GitLabSystemSource.
+// The chosen format and case matches the "event_name" attribute contained in
+// payloads sent by GitLab's system hooks.
+// https://docs.gitlab.com/ee/administration/system_hooks.html
+const (
+	GitLabSystemEventTypeGroupCreate         = "group_create"
+	GitLabSystemEventTypeGroupDestroy        = "group_destroy"
+	GitLabSystemEventTypeGroupRename         = "group_rename"
+	GitLabSystemEventTypeKeyCreate           = "key_create"
+	GitLabSystemEventTypeKeyDestroy          = "key_destroy"
+	GitLabSystemEventTypeMergeRequest        = "merge_request"
+	GitLabSystemEventTypeProjectCreate       = "project_create"
+	GitLabSystemEventTypeProjectDestroy      = "project_destroy"
+	GitLabSystemEventTypeProjectRename       = "project_rename"
+	GitLabSystemEventTypeProjectTransfer     = "project_transfer"
+	GitLabSystemEventTypeProjectUpdate       = "project_update"
+	GitLabSystemEventTypePush                = "push"
+	GitLabSystemEventTypeRepositoryUpdate    = "repository_update"
+	GitLabSystemEventTypeTagPush             = "tag_push"
+	GitLabSystemEventTypeUserAddToGroup      = "user_add_to_group"
+	GitLabSystemEventTypeUserAddToTeam       = "user_add_to_team"
+	GitLabSystemEventTypeUserCreate          = "user_create"
+	GitLabSystemEventTypeUserDestroy         = "user_destroy"
+	GitLabSystemEventTypeUserFailedLogin     = "user_failed_login"
+	GitLabSystemEventTypeUserRemoveFromGroup = "user_remove_from_group"
+	GitLabSystemEventTypeUserRemoveFromTeam  = "user_remove_from_team"
+	GitLabSystemEventTypeUserRename          = "user_rename"
+	GitLabSystemEventTypeUserUpdateForGroup  = "user_update_for_group"
+	GitLabSystemEventTypeUserUpdateForTeam   = "user_update_for_team"
+)
+
+// Optional triggers that can be enabled on a GitLab system hook.
+// https://docs.gitlab.com/ee/api/system_hooks.html#add-new-system-hook
+const (
+	GitLabSystemHookMergeRequests    = "merge_requests_events"
+	GitLabSystemHookPush             = "push_events"
+	GitLabSystemHookRepositoryUpdate = "repository_update_events"
+	GitLabSystemHookTagPush          = "tag_push_events"
+)
+
+// systemHookTriggers maps the optional triggers of a system hook to the type
+// of event they emit.
+var systemHookTriggers = map[string]string{
+	GitLabSystemHookMergeRequests:    GitLabSystemEventTypeMergeRequest,
+	GitLabSystemHookPush:             GitLabSystemEventTypePush,
+	GitLabSystemHookRepositoryUpdate: GitLabSystemEventTypeRepositoryUpdate,
+	GitLabSystemHookTagPush:          GitLabSystemEventTypeTagPush,
+}
+
+// systemEventTypesAlwaysOn are the types of events delivered by every system
+// hook, regardless of its optional triggers.
+var systemEventTypesAlwaysOn = []string{
+	GitLabSystemEventTypeGroupCreate,
+	GitLabSystemEventTypeGroupDestroy,
+	GitLabSystemEventTypeGroupRename,
+	GitLabSystemEventTypeKeyCreate,
+	GitLabSystemEventTypeKeyDestroy,
+	GitLabSystemEventTypeProjectCreate,
+	GitLabSystemEventTypeProjectDestroy,
+	GitLabSystemEventTypeProjectRename,
+	GitLabSystemEventTypeProjectTransfer,
+	GitLabSystemEventTypeProjectUpdate,
+	GitLabSystemEventTypeUserAddToGroup,
+	GitLabSystemEventTypeUserAddToTeam,
+	GitLabSystemEventTypeUserCreate,
+	GitLabSystemEventTypeUserDestroy,
+	GitLabSystemEventTypeUserFailedLogin,
+	GitLabSystemEventTypeUserRemoveFromGroup,
+	GitLabSystemEventTypeUserRemoveFromTeam,
+	GitLabSystemEventTypeUserRename,
+	GitLabSystemEventTypeUserUpdateForGroup,
+	GitLabSystemEventTypeUserUpdateForTeam,
+}
+
+// GitLabSystemEventType returns a GitLab system event type in a format
+// suitable for usage as a CloudEvent type attribute.
+func GitLabSystemEventType(eventName string) string {
+	return eventPrefixGitLabSystem + eventName
+}
+
+// EventTypes returns the types of events emitted by the source, sorted in
+// increasing lexical order.
+func (s *GitLabSystemSource) EventTypes() []string {
+	uniqueTypes := make(map[string]struct{}, len(systemEventTypesAlwaysOn)+len(s.Spec.EventTypes))
+
+	for _, typ := range systemEventTypesAlwaysOn {
+		uniqueTypes[typ] = struct{}{}
+	}
+	for _, trigger := range s.Spec.EventTypes {
+		if typ, ok := systemHookTriggers[trigger]; ok {
+			uniqueTypes[typ] = struct{}{}
+		}
+	}
+
+	types := make([]string, 0, len(uniqueTypes))
+
+	for typ := range uniqueTypes {
+		types = append(types, GitLabSystemEventType(typ))
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// AsEventSource returns a unique reference to the source suitable for use as a
+// CloudEvent source attribute.
+func (s *GitLabSystemSource) AsEventSource() string {
+	return s.Spec.InstanceURL
+}
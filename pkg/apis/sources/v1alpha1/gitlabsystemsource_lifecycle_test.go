@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemEventTypes(t *testing.T) {
+	testSrc := &GitLabSystemSource{
+		Spec: GitLabSystemSourceSpec{
+			EventTypes: []string{
+				GitLabSystemHookTagPush,
+				GitLabSystemHookPush,
+				GitLabSystemHookPush, // repeat a previous item
+			},
+		},
+	}
+
+	types := testSrc.EventTypes()
+
+	assert.IsIncreasing(t, types)
+	assert.Len(t, types, len(systemEventTypesAlwaysOn)+2)
+	assert.Contains(t, types, "dev.knative.sources.gitlab.system.project_create")
+	assert.Contains(t, types, "dev.knative.sources.gitlab.system.push")
+	assert.Contains(t, types, "dev.knative.sources.gitlab.system.tag_push")
+	assert.NotContains(t, types, "dev.knative.sources.gitlab.system.merge_request")
+}
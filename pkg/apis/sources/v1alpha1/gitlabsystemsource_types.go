@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+var (
+	_ apis.Validatable   = (*GitLabSystemSource)(nil)
+	_ apis.Defaultable   = (*GitLabSystemSource)(nil)
+	_ kmeta.OwnerRefable = (*GitLabSystemSource)(nil)
+	_ duckv1.KRShaped    = (*GitLabSystemSource)(nil)
+)
+
+// GitLabSystemSourceSpec defines the desired state of GitLabSystemSource
+// +kubebuilder:categories=all,knative,eventing,sources
+type GitLabSystemSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// ServiceAccountName holds the name of the Kubernetes service account
+	// as which the underlying K8s resources should be run. If unspecified
+	// this will default to the "default" service account for the namespace
+	// in which the GitLabSystemSource exists.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// InstanceURL is the base url of the GitLab instance for which we are
+	// interested to receive system events from.
+	// Examples:
+	//   https://gitlab.example.com
+	// +kubebuilder:validation:MinLength=1
+	InstanceURL string `json:"instanceUrl"`
+
+	// List of optional triggers to enable on the system hook, in addition to
+	// the project, group, user and key events which are always delivered.
+	// Those correspond to the attributes enumerated at
+	// https://docs.gitlab.com/ee/api/system_hooks.html#add-new-system-hook
+	// +optional
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// AccessToken is the Kubernetes secret containing the GitLab
+	// access token. The token must belong to an administrator.
+	AccessToken SecretValueFromSource `json:"accessToken"`
+
+	// SecretToken is the Kubernetes secret containing the GitLab
+	// secret token
+	SecretToken SecretValueFromSource `json:"secretToken"`
+
+	// SSLVerify if true configure webhook so the ssl verification is done when triggering the hook
+	SSLVerify bool `json:"sslverify,omitempty"`
+}
+
+// GitLabSystemSourceStatus defines the observed state of GitLabSystemSource
+type GitLabSystemSourceStatus struct {
+	// inherits duck/v1 SourceStatus, which currently provides:
+	// * ObservedGeneration - the 'Generation' of the Service that was last
+	//   processed by the controller.
+	// * Conditions - the latest available observations of a resource's current
+	//   state.
+	// * SinkURI - the current active sink URI that has been configured for the
+	//   Source.
+	duckv1.SourceStatus `json:",inline"`
+
+	// WebhookID of the system hook registered with GitLab
+	WebhookID *int `json:"webhookID,omitempty"`
+}
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitLabSystemSource is the Schema for the gitlabsystemsources API.
+type GitLabSystemSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitLabSystemSourceSpec   `json:"spec,omitempty"`
+	Status GitLabSystemSourceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitLabSystemSourceList contains a list of GitLabSystemSource.
+type GitLabSystemSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitLabSystemSource `json:"items"`
+}
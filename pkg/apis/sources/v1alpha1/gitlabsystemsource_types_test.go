@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+)
+
+func TestGitLabSystemSourceGetConditionSet(t *testing.T) {
+	r := &GitLabSystemSource{}
+
+	if got, want := r.GetConditionSet().GetTopLevelConditionType(), apis.ConditionReady; got != want {
+		t.Errorf("GetTopLevelCondition=%v, want=%v", got, want)
+	}
+}
+
+func TestGitLabSystemSource_GetGroupVersionKind(t *testing.T) {
+	gvk := (*GitLabSystemSource)(nil).GetGroupVersionKind()
+
+	expect := schema.GroupVersionKind{Group: "sources.knative.dev", Version: "v1alpha1", Kind: "GitLabSystemSource"}
+	if gvk != expect {
+		t.Errorf("Expected %v, got %v", expect, gvk)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate GitLab system source object fields
+func (s *GitLabSystemSource) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.Spec.Validate(ctx).ViaField("spec")
+
+	if apis.IsInUpdate(ctx) {
+		original := apis.GetBaseline(ctx).(*GitLabSystemSource)
+
+		// The existing system hook is deleted using the API token of the
+		// updated spec, so moving the source to a different GitLab instance
+		// would orphan it.
+		if original.Spec.InstanceURL != s.Spec.InstanceURL {
+			errs = errs.Also(&apis.FieldError{
+				Message: "Immutable fields changed (-old +new)",
+				Paths:   []string{"spec.instanceUrl"},
+				Details: "-" + original.Spec.InstanceURL + " +" + s.Spec.InstanceURL,
+			})
+		}
+	}
+
+	return errs
+}
+
+// Validate GitLab system source Spec object fields
+func (s *GitLabSystemSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	// Validate sink
+	if fieldErr := s.Sink.Validate(ctx); fieldErr != nil {
+		errs = errs.Also(fieldErr.ViaField("sink"))
+	}
+
+	// Validate GitLab instance
+	if s.InstanceURL == "" {
+		errs = errs.Also(apis.ErrMissingField("instanceUrl"))
+	} else if _, fieldErr := parseGitLabURL(s.InstanceURL); fieldErr != nil {
+		errs = errs.Also(fieldErr.ViaField("instanceUrl"))
+	}
+
+	// Validate secrets
+	errs = errs.Also(s.AccessToken.Validate(ctx).ViaField("accessToken"))
+	errs = errs.Also(s.SecretToken.Validate(ctx).ViaField("secretToken"))
+
+	// Validate optional system hook triggers
+	for i, typ := range s.EventTypes {
+		if _, ok := systemHookTriggers[typ]; !ok {
+			errs = errs.Also(apis.ErrInvalidArrayValue(typ, "eventTypes", i))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitLabSystemSourceValidation(t *testing.T) {
+	validSink := duckv1.Destination{URI: apis.HTTP("sink.example.com")}
+	validSecret := newValidGitLabSource().Spec.AccessToken
+
+	testCases := map[string]struct {
+		spec GitLabSystemSourceSpec
+		want *apis.FieldError
+	}{
+		"valid": {
+			spec: GitLabSystemSourceSpec{
+				SourceSpec:  duckv1.SourceSpec{Sink: validSink},
+				InstanceURL: "https://gitlab.example.com",
+				EventTypes:  []string{GitLabSystemHookPush, GitLabSystemHookMergeRequests},
+				AccessToken: validSecret,
+				SecretToken: validSecret,
+			},
+		},
+		"empty spec": {
+			spec: GitLabSystemSourceSpec{},
+			want: apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("spec.sink").
+				Also(apis.ErrMissingField("spec.instanceUrl", "spec.accessToken.secretKeyRef", "spec.secretToken.secretKeyRef")),
+		},
+		"invalid instance URL": {
+			spec: GitLabSystemSourceSpec{
+				SourceSpec:  duckv1.SourceSpec{Sink: validSink},
+				InstanceURL: "gitlab.example.com",
+				AccessToken: validSecret,
+				SecretToken: validSecret,
+			},
+			want: apis.ErrInvalidValue("gitlab.example.com", "spec.instanceUrl", "the URL scheme must be http or https"),
+		},
+		"unknown trigger": {
+			spec: GitLabSystemSourceSpec{
+				SourceSpec:  duckv1.SourceSpec{Sink: validSink},
+				InstanceURL: "https://gitlab.example.com",
+				EventTypes:  []string{GitLabSystemHookPush, "issues_events"},
+				AccessToken: validSecret,
+				SecretToken: validSecret,
+			},
+			want: apis.ErrInvalidArrayValue("issues_events", "eventTypes", 1).ViaField("spec"),
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			src := &GitLabSystemSource{Spec: tc.spec}
+			got := src.Validate(context.Background())
+			if diff := cmp.Diff(tc.want.Error(), got.Error()); diff != "" {
+				t.Errorf("validate (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
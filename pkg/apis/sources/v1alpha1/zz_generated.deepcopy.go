@@ -91,13 +91,22 @@ func (in *GitLabSourceList) DeepCopyObject() runtime.Object {
 func (in *GitLabSourceSpec) DeepCopyInto(out *GitLabSourceSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.ProjectURLs != nil {
+		in, out := &in.ProjectURLs, &out.ProjectURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.EventTypes != nil {
 		in, out := &in.EventTypes, &out.EventTypes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	in.AccessToken.DeepCopyInto(&out.AccessToken)
-	in.SecretToken.DeepCopyInto(&out.SecretToken)
+	if in.SecretToken != nil {
+		in, out := &in.SecretToken, &out.SecretToken
+		*out = new(SecretValueFromSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -115,6 +124,11 @@ func (in *GitLabSourceSpec) DeepCopy() *GitLabSourceSpec {
 func (in *GitLabSourceStatus) DeepCopyInto(out *GitLabSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]WebhookStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.WebhookID != nil {
 		in, out := &in.WebhookID, &out.WebhookID
 		*out = new(int)
@@ -133,6 +147,113 @@ func (in *GitLabSourceStatus) DeepCopy() *GitLabSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabSystemSource) DeepCopyInto(out *GitLabSystemSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabSystemSource.
+func (in *GitLabSystemSource) DeepCopy() *GitLabSystemSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabSystemSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitLabSystemSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabSystemSourceList) DeepCopyInto(out *GitLabSystemSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitLabSystemSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabSystemSourceList.
+func (in *GitLabSystemSourceList) DeepCopy() *GitLabSystemSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabSystemSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitLabSystemSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabSystemSourceSpec) DeepCopyInto(out *GitLabSystemSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.AccessToken.DeepCopyInto(&out.AccessToken)
+	in.SecretToken.DeepCopyInto(&out.SecretToken)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabSystemSourceSpec.
+func (in *GitLabSystemSourceSpec) DeepCopy() *GitLabSystemSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabSystemSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabSystemSourceStatus) DeepCopyInto(out *GitLabSystemSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	if in.WebhookID != nil {
+		in, out := &in.WebhookID, &out.WebhookID
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabSystemSourceStatus.
+func (in *GitLabSystemSourceStatus) DeepCopy() *GitLabSystemSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabSystemSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretValueFromSource) DeepCopyInto(out *SecretValueFromSource) {
 	*out = *in
@@ -153,3 +274,19 @@ func (in *SecretValueFromSource) DeepCopy() *SecretValueFromSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookStatus) DeepCopyInto(out *WebhookStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookStatus.
+func (in *WebhookStatus) DeepCopy() *WebhookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
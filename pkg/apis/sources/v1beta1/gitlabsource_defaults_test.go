@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitlabSourceDefaults(t *testing.T) {
+	testCases := map[string]struct {
+		initial  GitLabSource
+		expected GitLabSource
+	}{
+		"nil spec": {
+			initial: GitLabSource{},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{},
+			},
+		},
+		"canonical URLs": {
+			initial: GitLabSource{
+				Spec: GitLabSourceSpec{
+					ProjectURL: "HTTPS://GitLab.Example.com/MyGroup/MyProject.git/",
+					ProjectURLs: []string{
+						"https://gitlab.example.com/mygroup/otherproject/",
+						"https://gitlab.example.com/mygroup/otherproject.git",
+					},
+				},
+			},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{
+					ProjectURL:  "https://gitlab.example.com/MyGroup/MyProject",
+					ProjectURLs: []string{"https://gitlab.example.com/mygroup/otherproject"},
+				},
+			},
+		},
+		"friendly event names": {
+			initial: GitLabSource{
+				Spec: GitLabSourceSpec{
+					EventTypes: []string{"push", "merge_request", "push_events", "confidential_issue", "Issue"},
+				},
+			},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{
+					EventTypes: []string{
+						GitLabWebhookConfidentialIssues,
+						GitLabWebhookIssues,
+						GitLabWebhookMergeRequests,
+						GitLabWebhookPush,
+					},
+				},
+			},
+		},
+		"sink namespace": {
+			initial: GitLabSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "myns"},
+				Spec: GitLabSourceSpec{
+					SourceSpec: duckv1.SourceSpec{Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{Kind: "Service", Name: "mysvc"},
+					}},
+				},
+			},
+			expected: GitLabSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "myns"},
+				Spec: GitLabSourceSpec{
+					SourceSpec: duckv1.SourceSpec{Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{Kind: "Service", Name: "mysvc", Namespace: "myns"},
+					}},
+				},
+			},
+		},
+		"branch filter without strategy": {
+			initial: GitLabSource{
+				Spec: GitLabSourceSpec{
+					PushEventsBranchFilter: "release/*",
+				},
+			},
+			expected: GitLabSource{
+				Spec: GitLabSourceSpec{
+					PushEventsBranchFilter: "release/*",
+					BranchFilterStrategy:   BranchFilterStrategyWildcard,
+				},
+			},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			tc.initial.SetDefaults(context.TODO())
+			if diff := cmp.Diff(tc.expected, tc.initial); diff != "" {
+				t.Fatalf("Unexpected defaults (-want, +got): %s", diff)
+			}
+		})
+	}
+}
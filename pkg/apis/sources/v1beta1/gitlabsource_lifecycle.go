@@ -0,0 +1,484 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	// GitLabSourceConditionReady has status True when the
+	// GitLabSource is ready to send events.
+	GitLabSourceConditionReady = apis.ConditionReady
+
+	// GitLabSourceConditionSinkProvided has status True when the
+	// GitLabSource has been configured with a sink target.
+	GitLabSourceConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// GitLabSourceConditionWebhookConfigured has a status True when the
+	// GitLabSource has been configured with a webhook.
+	GitLabSourceConditionWebhookConfigured apis.ConditionType = "WebhookConfigured"
+
+	// GitLabSourceConditionDeployed has status True when the
+	// GitLabSource's receive adapter has been successfully deployed.
+	GitLabSourceConditionDeployed apis.ConditionType = "Deployed"
+
+	// GitLabSourceConditionWebhookInSync has status True when the
+	// configuration of the GitLabSource's hooks in GitLab matches its spec.
+	// It doesn't contribute to the readiness of the GitLabSource.
+	GitLabSourceConditionWebhookInSync apis.ConditionType = "WebhookInSync"
+
+	// GitLabSourceConditionAdapterUpToDate has status True when the
+	// GitLabSource's receive adapter runs its latest desired configuration.
+	// It doesn't contribute to the readiness of the GitLabSource.
+	GitLabSourceConditionAdapterUpToDate apis.ConditionType = "AdapterUpToDate"
+
+	// GitLabSourceConditionCredentialsValid has status True when the
+	// GitLabSource's API token was verified to be allowed to manage the
+	// hooks of its GitLab projects or group.
+	// It doesn't contribute to the readiness of the GitLabSource, which
+	// reflects invalid credentials through the WebhookConfigured condition.
+	GitLabSourceConditionCredentialsValid apis.ConditionType = "CredentialsValid"
+
+	// GitLabSourceConditionSecretsWatched has status True when all the
+	// Secrets referenced by the GitLabSource carry the label which lets the
+	// controller watch them.
+	// It doesn't contribute to the readiness of the GitLabSource.
+	GitLabSourceConditionSecretsWatched apis.ConditionType = "SecretsWatched"
+)
+
+var gitLabSourceCondSet = apis.NewLivingConditionSet(
+	GitLabSourceConditionSinkProvided,
+	GitLabSourceConditionDeployed,
+	GitLabSourceConditionWebhookConfigured,
+)
+
+// GetGroupVersionKind returns a GitLabSource GVK. Implements the kmeta.OwnerRefable interface.
+func (*GitLabSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("GitLabSource")
+}
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*GitLabSource) GetConditionSet() apis.ConditionSet {
+	return gitLabSourceCondSet
+}
+
+// GetStatus retrieves the duck status for this resource. Implements the KRShaped interface.
+func (g *GitLabSource) GetStatus() *duckv1.Status {
+	return &g.Status.Status
+}
+
+// MarkSink sets the SinkProvided condition to True using the given URI.
+func (s *GitLabSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri == nil {
+		gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionSinkProvided,
+			"EmptySinkURI", "The sink has no URI")
+		return
+	}
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionSinkProvided)
+}
+
+// MarkNoSink sets the SinkProvided condition to False.
+func (s *GitLabSourceStatus) MarkNoSink() {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionSinkProvided,
+		"SinkNotFound", "The sink does not exist or its URI is not set")
+}
+
+// MarkWebhook sets the WebhookConfigured condition to True.
+func (s *GitLabSourceStatus) MarkWebhook() {
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionWebhookConfigured)
+}
+
+// MarkNoWebhook sets the WebhookConfigured condition to False with the given reason and message.
+func (s *GitLabSourceStatus) MarkNoWebhook(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionWebhookConfigured, reason, messageFormat, messageA...)
+}
+
+// MarkWebhookInSync sets the WebhookInSync condition to True.
+func (s *GitLabSourceStatus) MarkWebhookInSync() {
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionWebhookInSync)
+}
+
+// MarkWebhookDrift sets the WebhookInSync condition to False with the given reason and message.
+func (s *GitLabSourceStatus) MarkWebhookDrift(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionWebhookInSync, reason, messageFormat, messageA...)
+}
+
+// MarkAdapterUpToDate sets the AdapterUpToDate condition to True.
+func (s *GitLabSourceStatus) MarkAdapterUpToDate() {
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionAdapterUpToDate)
+}
+
+// MarkAdapterOutOfDate sets the AdapterUpToDate condition to False with the given message.
+func (s *GitLabSourceStatus) MarkAdapterOutOfDate(messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionAdapterUpToDate,
+		"AdapterOutOfDate", messageFormat, messageA...)
+}
+
+// MarkCredentialsValid sets the CredentialsValid condition to True.
+func (s *GitLabSourceStatus) MarkCredentialsValid() {
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionCredentialsValid)
+}
+
+// MarkCredentialsInvalid sets the CredentialsValid condition to False with the given reason and message.
+func (s *GitLabSourceStatus) MarkCredentialsInvalid(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionCredentialsValid, reason, messageFormat, messageA...)
+}
+
+// MarkCredentialsUnknown sets the CredentialsValid condition to Unknown with the given reason and message.
+func (s *GitLabSourceStatus) MarkCredentialsUnknown(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkUnknown(GitLabSourceConditionCredentialsValid, reason, messageFormat, messageA...)
+}
+
+// MarkSecretsWatched sets the SecretsWatched condition to True.
+func (s *GitLabSourceStatus) MarkSecretsWatched() {
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionSecretsWatched)
+}
+
+// MarkSecretsNotWatched sets the SecretsWatched condition to False with the given message.
+func (s *GitLabSourceStatus) MarkSecretsNotWatched(messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionSecretsWatched,
+		"SecretNotLabeled", messageFormat, messageA...)
+}
+
+// MarkWebhook sets the Deployed condition to True.
+func (s *GitLabSourceStatus) MarkDeployed() {
+	gitLabSourceCondSet.Manage(s).MarkTrue(GitLabSourceConditionDeployed)
+}
+
+// MarkNotDeployed sets the Deployed condition to False with the given reason and message.
+func (s *GitLabSourceStatus) MarkNotDeployed(reason, messageFormat string, messageA ...interface{}) {
+	gitLabSourceCondSet.Manage(s).MarkFalse(GitLabSourceConditionDeployed, reason, messageFormat, messageA...)
+}
+
+// String prepended to GitLab event types to make them fully-qualified.
+const eventPrefixGitLab = "dev.knative.sources.gitlab."
+
+// Types of events emitted by a GitLabSource.
+// The chosen format and case matches the "object_kind" attribute contained in
+// payloads sent by GitLab's webhooks.
+// https://docs.gitlab.com/ee/user/project/integrations/webhooks.html#events
+const (
+	GitLabEventTypeDeployment          = "deployment"
+	GitLabEventTypeFeatureFlag         = "feature_flag"
+	GitLabEventTypeIssue               = "issue"
+	GitLabEventTypeJob                 = "job"
+	GitLabEventTypeMember              = "member"
+	GitLabEventTypeMergeRequest        = "merge_request"
+	GitLabEventTypeNote                = "note"
+	GitLabEventTypePipeline            = "pipeline"
+	GitLabEventTypePush                = "push"
+	GitLabEventTypeRelease             = "release"
+	GitLabEventTypeResourceAccessToken = "resource_access_token"
+	GitLabEventTypeSubgroup            = "subgroup"
+	GitLabEventTypeTagPush             = "tag_push"
+	GitLabEventTypeWikiPage            = "wiki_page"
+
+	// Type of job events delivered by older GitLab versions.
+	GitLabEventTypeBuild = "build"
+)
+
+// Types of webhooks that can be enabled on a GitLab project or group.
+// https://docs.gitlab.com/ee/api/projects.html#add-project-hook
+// https://docs.gitlab.com/ee/api/groups.html#add-group-hook
+const (
+	GitLabWebhookConfidentialIssues  = "confidential_issues_events"
+	GitLabWebhookConfidentialNote    = "confidential_note_events"
+	GitLabWebhookDeployment          = "deployment_events"
+	GitLabWebhookFeatureFlag         = "feature_flag_events"
+	GitLabWebhookIssues              = "issues_events"
+	GitLabWebhookJob                 = "job_events"
+	GitLabWebhookMember              = "member_events"
+	GitLabWebhookMergeRequests       = "merge_requests_events"
+	GitLabWebhookNote                = "note_events"
+	GitLabWebhookPipeline            = "pipeline_events"
+	GitLabWebhookPush                = "push_events"
+	GitLabWebhookReleases            = "releases_events"
+	GitLabWebhookResourceAccessToken = "resource_access_token_events"
+	GitLabWebhookSubgroup            = "subgroup_events"
+	GitLabWebhookTagPush             = "tag_push_events"
+	GitLabWebhookWikiPage            = "wiki_page_events"
+)
+
+// eventTypesByWebhook maps the webhooks which can be enabled on a GitLab hook
+// to the type of event they emit.
+var eventTypesByWebhook = map[string]string{
+	GitLabWebhookConfidentialIssues:  GitLabEventTypeIssue,
+	GitLabWebhookConfidentialNote:    GitLabEventTypeNote,
+	GitLabWebhookDeployment:          GitLabEventTypeDeployment,
+	GitLabWebhookFeatureFlag:         GitLabEventTypeFeatureFlag,
+	GitLabWebhookIssues:              GitLabEventTypeIssue,
+	GitLabWebhookJob:                 GitLabEventTypeJob,
+	GitLabWebhookMember:              GitLabEventTypeMember,
+	GitLabWebhookMergeRequests:       GitLabEventTypeMergeRequest,
+	GitLabWebhookNote:                GitLabEventTypeNote,
+	GitLabWebhookPipeline:            GitLabEventTypePipeline,
+	GitLabWebhookPush:                GitLabEventTypePush,
+	GitLabWebhookReleases:            GitLabEventTypeRelease,
+	GitLabWebhookResourceAccessToken: GitLabEventTypeResourceAccessToken,
+	GitLabWebhookSubgroup:            GitLabEventTypeSubgroup,
+	GitLabWebhookTagPush:             GitLabEventTypeTagPush,
+	GitLabWebhookWikiPage:            GitLabEventTypeWikiPage,
+}
+
+// legacyEventTypesByWebhook maps webhooks to the type of event they also emit
+// for compatibility with earlier releases, so that the Triggers which filter
+// on that type keep matching.
+var legacyEventTypesByWebhook = map[string]string{
+	// Job events are emitted as build events when GitLab delivers them with
+	// the "Build Hook" header of older GitLab versions.
+	GitLabWebhookJob: GitLabEventTypeBuild,
+}
+
+// Types of webhooks that GitLab offers but which can not be enabled on the
+// hooks of sources.
+const (
+	GitLabWebhookEmoji         = "emoji_events"
+	GitLabWebhookVulnerability = "vulnerability_events"
+)
+
+// unsupportedWebhooks maps the webhooks which can not be enabled on the hooks
+// of sources to the reason why.
+var unsupportedWebhooks = map[string]string{
+	// The GitLab client neither enables emoji events on project hooks nor
+	// parses their payloads, which receive adapters would therefore reject.
+	GitLabWebhookEmoji: "emoji events can not be parsed by receive adapters",
+	// Vulnerability events are unknown to the GitLab client.
+	GitLabWebhookVulnerability: "vulnerability events can not be parsed by receive adapters",
+}
+
+// groupOnlyWebhooks are the webhooks which can only be enabled on the hooks
+// of GitLab groups.
+var groupOnlyWebhooks = map[string]struct{}{
+	GitLabWebhookFeatureFlag: {},
+	GitLabWebhookMember:      {},
+	GitLabWebhookSubgroup:    {},
+}
+
+// GitLabEventType returns a GitLab event type in a format suitable for usage
+// as a CloudEvent type attribute.
+func GitLabEventType(eventType string) string {
+	return eventPrefixGitLab + eventType
+}
+
+// EventTypes returns the types of events emitted by the source, sorted in
+// increasing lexical order.
+// Webhooks which can not be enabled on the source's hooks are ignored.
+func (s *GitLabSource) EventTypes() []string {
+	// Some webhooks emit the same event type, so we use a map as an
+	// intermediate store to avoid duplicates in the returned slice.
+	uniqueTypes := make(map[string]struct{}, len(s.Spec.EventTypes))
+
+	unsupported := make(map[string]struct{})
+	for _, hook := range s.UnsupportedEventTypes() {
+		unsupported[hook] = struct{}{}
+	}
+
+	for _, hook := range s.Spec.EventTypes {
+		if _, isUnsupported := unsupported[hook]; isUnsupported {
+			continue
+		}
+		uniqueTypes[eventTypesByWebhook[hook]] = struct{}{}
+		if legacyType, hasLegacyType := legacyEventTypesByWebhook[hook]; hasLegacyType {
+			uniqueTypes[legacyType] = struct{}{}
+		}
+	}
+
+	types := make([]string, 0, len(uniqueTypes))
+
+	for typ := range uniqueTypes {
+		types = append(types, GitLabEventType(typ))
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// UnsupportedEventTypes returns the webhooks from the source's spec which can
+// not be enabled on the source's hooks, either because they are unknown, or
+// because they are only available on groups and the source isn't a group
+// source.
+func (s *GitLabSource) UnsupportedEventTypes() []string {
+	var unsupported []string
+
+	for _, hook := range s.Spec.EventTypes {
+		if _, isKnown := eventTypesByWebhook[hook]; !isKnown {
+			unsupported = append(unsupported, hook)
+			continue
+		}
+		if _, isGroupOnly := groupOnlyWebhooks[hook]; isGroupOnly && !s.IsGroupSource() {
+			unsupported = append(unsupported, hook)
+		}
+	}
+
+	return unsupported
+}
+
+// IsGroupSource returns whether the source receives events from a GitLab group
+// instead of a single GitLab project.
+func (s *GitLabSource) IsGroupSource() bool {
+	return s.Spec.GroupURL != ""
+}
+
+// Projects returns the URLs of the GitLab projects the source receives events
+// from, without duplicates, in the order in which they are declared.
+func (s *GitLabSource) Projects() []string {
+	projects := make([]string, 0, len(s.Spec.ProjectURLs)+1)
+	seen := make(map[string]struct{}, len(s.Spec.ProjectURLs)+1)
+
+	for _, u := range append([]string{s.Spec.ProjectURL}, s.Spec.ProjectURLs...) {
+		if _, isDup := seen[u]; isDup || u == "" {
+			continue
+		}
+		seen[u] = struct{}{}
+		projects = append(projects, u)
+	}
+
+	return projects
+}
+
+// IsMultiProjectSource returns whether the source may receive events from more
+// than one GitLab project, either from a group or from a list of projects.
+func (s *GitLabSource) IsMultiProjectSource() bool {
+	return s.IsGroupSource() || len(s.Projects()) > 1
+}
+
+// WebhookTargets returns the URLs of the GitLab projects or group which a
+// hook must be registered with.
+func (s *GitLabSource) WebhookTargets() []string {
+	if s.IsGroupSource() {
+		return []string{s.Spec.GroupURL}
+	}
+	return s.Projects()
+}
+
+// NewWebhookStatus returns the status of a hook registered with the given
+// GitLab project or group.
+func (s *GitLabSource) NewWebhookStatus(target string, hookID int) WebhookStatus {
+	if s.IsGroupSource() {
+		return WebhookStatus{GroupURL: target, ID: hookID}
+	}
+	return WebhookStatus{ProjectURL: target, ID: hookID}
+}
+
+// Target returns the URL of the GitLab project or group the hook is
+// registered with.
+func (w *WebhookStatus) Target() string {
+	if w.GroupURL != "" {
+		return w.GroupURL
+	}
+	return w.ProjectURL
+}
+
+// GeneratedSecretTokenKey is the key of the secret token inside Secrets
+// generated by the controller.
+const GeneratedSecretTokenKey = "secretToken"
+
+// SecretTokenRef returns a reference to the secret token used by the source's
+// hooks: either the one declared in the spec, or the one generated by the
+// controller. Returns nil if no secret token was generated yet.
+func (s *GitLabSource) SecretTokenRef() *corev1.SecretKeySelector {
+	if s.Spec.SecretToken != nil {
+		return s.Spec.SecretToken.SecretKeyRef
+	}
+
+	if s.Status.SecretTokenSecretName == "" {
+		return nil
+	}
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: s.Status.SecretTokenSecretName,
+		},
+		Key: GeneratedSecretTokenKey,
+	}
+}
+
+// DeclaredAccessTokenRef returns a reference to the API token declared in the
+// spec of the given source, or nil if the controller provisions access tokens
+// for the source.
+func (s *GitLabSource) DeclaredAccessTokenRef() *corev1.SecretKeySelector {
+	if s.Spec.AccessToken == nil {
+		return nil
+	}
+	return s.Spec.AccessToken.SecretKeyRef
+}
+
+// AccessTokenRef returns a reference to the API token used for managing the
+// hook of the given GitLab project or group: either the one declared in the
+// spec, or the project access token provisioned by the controller. Returns
+// nil if no access token was provisioned for the project yet.
+func (s *GitLabSource) AccessTokenRef(target string) *corev1.SecretKeySelector {
+	if ref := s.DeclaredAccessTokenRef(); ref != nil {
+		return ref
+	}
+
+	for i := range s.Status.ProvisionedAccessTokens {
+		if tok := &s.Status.ProvisionedAccessTokens[i]; tok.ProjectURL == target {
+			return &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: s.Status.AccessTokenSecretName,
+				},
+				Key: tok.SecretKey(),
+			}
+		}
+	}
+
+	return nil
+}
+
+// SecretKey returns the key of the token inside the Secret which contains the
+// source's provisioned access tokens. The key changes whenever the token is
+// rotated.
+func (t *ProvisionedAccessToken) SecretKey() string {
+	return "token-" + strconv.Itoa(t.TokenID)
+}
+
+// PreviousSecretTokenRef returns a reference to the secret token previously
+// used by the source's hooks, which remains valid while the secret token is
+// being rotated. Returns nil outside of rotations.
+func (s *GitLabSource) PreviousSecretTokenRef() *corev1.SecretKeySelector {
+	if s.Status.SecretTokenRotation == nil {
+		return nil
+	}
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: s.Status.SecretTokenRotation.PreviousSecretName,
+		},
+		Key: GeneratedSecretTokenKey,
+	}
+}
+
+// AsEventSource returns a unique reference to the source suitable for use as a
+// CloudEvent source attribute.
+// Group sources and sources with multiple projects emit events with the URL of
+// the originating project as source attribute whenever the payload carries
+// it, and fall back to the URL of the group or first project otherwise.
+func (s *GitLabSource) AsEventSource() string {
+	if targets := s.WebhookTargets(); len(targets) > 0 {
+		return targets[0]
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+)
+
+func TestEventTypes(t *testing.T) {
+	definedWebhooks := []string{
+		GitLabWebhookPush,               // "push"
+		GitLabWebhookMergeRequests,      // "merge_request"
+		GitLabWebhookPush,               // repeat a previous item
+		GitLabWebhookConfidentialIssues, // / pick webhooks that emit...
+		GitLabWebhookIssues,             // \ ...the same event type ("issue")
+		GitLabWebhookJob,                // "job", and the legacy "build"
+	}
+
+	expectTypes := []string{ // sorted
+		"dev.knative.sources.gitlab.build",
+		"dev.knative.sources.gitlab.issue",
+		"dev.knative.sources.gitlab.job",
+		"dev.knative.sources.gitlab.merge_request",
+		"dev.knative.sources.gitlab.push",
+	}
+
+	testSrc := &GitLabSource{
+		Spec: GitLabSourceSpec{
+			EventTypes: definedWebhooks,
+		},
+	}
+
+	assert.Equal(t, expectTypes, testSrc.EventTypes())
+}
+
+func TestAsEventSource(t *testing.T) {
+	const (
+		projectURL = "https://gitlab.example.com/mygroup/myproject"
+		groupURL   = "https://gitlab.example.com/mygroup"
+	)
+
+	projectSrc := &GitLabSource{Spec: GitLabSourceSpec{ProjectURL: projectURL}}
+	assert.False(t, projectSrc.IsGroupSource())
+	assert.Equal(t, projectURL, projectSrc.AsEventSource())
+
+	groupSrc := &GitLabSource{Spec: GitLabSourceSpec{GroupURL: groupURL}}
+	assert.True(t, groupSrc.IsGroupSource())
+	assert.Equal(t, groupURL, groupSrc.AsEventSource())
+}
+
+func TestWebhookTargets(t *testing.T) {
+	const (
+		project1URL = "https://gitlab.example.com/mygroup/project1"
+		project2URL = "https://gitlab.example.com/mygroup/project2"
+		groupURL    = "https://gitlab.example.com/mygroup"
+	)
+
+	singleSrc := &GitLabSource{Spec: GitLabSourceSpec{ProjectURL: project1URL}}
+	assert.Equal(t, []string{project1URL}, singleSrc.WebhookTargets())
+	assert.False(t, singleSrc.IsMultiProjectSource())
+
+	multiSrc := &GitLabSource{Spec: GitLabSourceSpec{
+		ProjectURL:  project2URL,
+		ProjectURLs: []string{project1URL, project2URL},
+	}}
+	assert.Equal(t, []string{project2URL, project1URL}, multiSrc.WebhookTargets())
+	assert.True(t, multiSrc.IsMultiProjectSource())
+	assert.Equal(t, project2URL, multiSrc.AsEventSource())
+
+	groupSrc := &GitLabSource{Spec: GitLabSourceSpec{GroupURL: groupURL}}
+	assert.Equal(t, []string{groupURL}, groupSrc.WebhookTargets())
+	assert.True(t, groupSrc.IsMultiProjectSource())
+	assert.Equal(t, WebhookStatus{GroupURL: groupURL, ID: 1}, groupSrc.NewWebhookStatus(groupURL, 1))
+}
+
+func TestSecretTokenRef(t *testing.T) {
+	src := &GitLabSource{}
+	assert.Nil(t, src.SecretTokenRef())
+
+	src.Status.SecretTokenSecretName = "generated"
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "generated"},
+		Key:                  GeneratedSecretTokenKey,
+	}, src.SecretTokenRef())
+
+	specRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+		Key:                  "secretToken",
+	}
+	src.Spec.SecretToken = &SecretValueFromSource{SecretKeyRef: specRef}
+	assert.Equal(t, specRef, src.SecretTokenRef())
+}
+
+func TestPreviousSecretTokenRef(t *testing.T) {
+	src := &GitLabSource{}
+	assert.Nil(t, src.PreviousSecretTokenRef())
+
+	src.Status.SecretTokenRotation = &SecretTokenRotationStatus{PreviousSecretName: "applied"}
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "applied"},
+		Key:                  GeneratedSecretTokenKey,
+	}, src.PreviousSecretTokenRef())
+}
+
+func TestAccessTokenRef(t *testing.T) {
+	const project = "https://gitlab.example.com/mygroup/myproject"
+
+	src := &GitLabSource{}
+	assert.Nil(t, src.AccessTokenRef(project))
+
+	src.Status.AccessTokenSecretName = "provisioned"
+	src.Status.ProvisionedAccessTokens = []ProvisionedAccessToken{{
+		ProjectURL: project,
+		ProjectID:  42,
+		TokenID:    7,
+	}}
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "provisioned"},
+		Key:                  "token-7",
+	}, src.AccessTokenRef(project))
+	assert.Nil(t, src.AccessTokenRef("https://gitlab.example.com/mygroup/otherproject"))
+
+	declared := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "declared"},
+		Key:                  "accessToken",
+	}
+	src.Spec.AccessToken = &SecretValueFromSource{SecretKeyRef: declared}
+	assert.Equal(t, declared, src.AccessTokenRef(project))
+}
+
+func TestCredentialsValidCondition(t *testing.T) {
+	s := &GitLabSourceStatus{}
+	s.MarkSink(apis.HTTP("sink.example.com"))
+	s.MarkDeployed()
+	s.MarkWebhook()
+
+	s.MarkCredentialsInvalid("InsufficientScope", "The token lacks the %q scope", "api")
+
+	cond := s.GetCondition(GitLabSourceConditionCredentialsValid)
+	assert.True(t, cond.IsFalse())
+	assert.Equal(t, "InsufficientScope", cond.Reason)
+	assert.True(t, gitLabSourceCondSet.Manage(s).IsHappy(), "CredentialsValid doesn't contribute to readiness")
+
+	s.MarkCredentialsValid()
+	assert.True(t, s.GetCondition(GitLabSourceConditionCredentialsValid).IsTrue())
+}
+
+func TestUnsupportedEventTypes(t *testing.T) {
+	definedWebhooks := []string{
+		GitLabWebhookPush,
+		GitLabWebhookReleases,
+		GitLabWebhookMember, // only available on groups
+		GitLabWebhookEmoji,  // not supported
+	}
+
+	projectSrc := &GitLabSource{Spec: GitLabSourceSpec{
+		ProjectURL: "https://gitlab.example.com/mygroup/myproject",
+		EventTypes: definedWebhooks,
+	}}
+	assert.Equal(t, []string{GitLabWebhookMember, GitLabWebhookEmoji}, projectSrc.UnsupportedEventTypes())
+	assert.Equal(t, []string{
+		"dev.knative.sources.gitlab.push",
+		"dev.knative.sources.gitlab.release",
+	}, projectSrc.EventTypes())
+
+	groupSrc := &GitLabSource{Spec: GitLabSourceSpec{
+		GroupURL:   "https://gitlab.example.com/mygroup",
+		EventTypes: definedWebhooks,
+	}}
+	assert.Equal(t, []string{GitLabWebhookEmoji}, groupSrc.UnsupportedEventTypes())
+	assert.Equal(t, []string{
+		"dev.knative.sources.gitlab.member",
+		"dev.knative.sources.gitlab.push",
+		"dev.knative.sources.gitlab.release",
+	}, groupSrc.EventTypes())
+}
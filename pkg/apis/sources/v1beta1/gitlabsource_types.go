@@ -0,0 +1,471 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+var (
+	_ apis.Validatable   = (*GitLabSource)(nil)
+	_ apis.Defaultable   = (*GitLabSource)(nil)
+	_ apis.Convertible   = (*GitLabSource)(nil)
+	_ kmeta.OwnerRefable = (*GitLabSource)(nil)
+	_ duckv1.KRShaped    = (*GitLabSource)(nil)
+)
+
+// GitLabSourceSpec defines the desired state of GitLabSource
+// +kubebuilder:categories=all,knative,eventing,sources
+type GitLabSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// ServiceAccountName holds the name of the Kubernetes service account
+	// as which the underlying K8s resources should be run. If unspecified
+	// this will default to the "default" service account for the namespace
+	// in which the GitLabSource exists.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// ProjectURL is the url of the GitLab project for which we are interested
+	// to receive events from.
+	// Mutually exclusive with GroupURL.
+	// Examples:
+	//   https://gitlab.com/gitlab-org/gitlab-foss
+	// +optional
+	ProjectURL string `json:"projectUrl,omitempty"`
+
+	// ProjectURLs is a list of urls of GitLab projects for which we are
+	// interested to receive events from, in addition to ProjectURL. A hook
+	// is registered with each project, and the events of all projects are
+	// delivered through a single receive adapter.
+	// Mutually exclusive with GroupURL.
+	// +optional
+	ProjectURLs []string `json:"projectUrls,omitempty"`
+
+	// GroupURL is the url of the GitLab group for which we are interested
+	// to receive events from. A single group hook is registered, which
+	// delivers events for every project contained in the group.
+	// Mutually exclusive with ProjectURL and ProjectURLs.
+	// Examples:
+	//   https://gitlab.com/gitlab-org
+	// +optional
+	GroupURL string `json:"groupUrl,omitempty"`
+
+	// List of webhooks to enable on the selected GitLab project or group.
+	// Those correspond to the attributes enumerated at
+	// https://docs.gitlab.com/ee/api/projects.html#add-project-hook and
+	// https://docs.gitlab.com/ee/api/groups.html#add-group-hook
+	EventTypes []string `json:"eventTypes"`
+
+	// AccessToken is the Kubernetes secret containing the GitLab
+	// access token. When omitted from the spec of a source which receives
+	// events from projects, the controller provisions a project access
+	// token for each project, if it is configured to.
+	// +optional
+	AccessToken *SecretValueFromSource `json:"accessToken,omitempty"`
+
+	// SecretToken is the Kubernetes secret containing the GitLab
+	// secret token. When omitted, the controller generates a random token
+	// and stores it in a Secret owned by the source.
+	// +optional
+	SecretToken *SecretValueFromSource `json:"secretToken,omitempty"`
+
+	// SSLVerify enables the verification of the adapter's SSL certificate
+	// when GitLab delivers events to the hook.
+	// +optional
+	SSLVerify bool `json:"sslVerify,omitempty"`
+
+	// PushEventsBranchFilter restricts the delivery of push events to
+	// branches which match the filter. The filter is interpreted according
+	// to BranchFilterStrategy.
+	// Examples:
+	//   main
+	//   release/*
+	// +optional
+	PushEventsBranchFilter string `json:"pushEventsBranchFilter,omitempty"`
+
+	// BranchFilterStrategy is the strategy used by GitLab to match branches
+	// against PushEventsBranchFilter. One of "wildcard", "regex" or
+	// "all_branches". Defaults to "wildcard" when a filter is set.
+	// +optional
+	BranchFilterStrategy string `json:"branchFilterStrategy,omitempty"`
+
+	// WebhookDriftPolicy determines how the controller handles changes
+	// applied to the source's hooks outside of Kubernetes, e.g. through the
+	// GitLab UI. One of "Correct" or "Report". "Correct" (default) restores
+	// the configuration declared in the spec, while "Report" only reports
+	// the drift in the WebhookInSync condition of the source.
+	// +optional
+	WebhookDriftPolicy WebhookDriftPolicy `json:"webhookDriftPolicy,omitempty"`
+
+	// DeletionPolicy determines what happens to the source's hooks when the
+	// source is deleted. One of "Delete" or "Retain". "Delete" (default)
+	// removes the hooks from GitLab, while "Retain" keeps them, so that they
+	// can be adopted by a source with the same namespace and name in the
+	// same cluster, or by a source which names the deleted source in
+	// AdoptWebhooksFrom.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptWebhooksFrom identifies a deleted source whose retained hooks
+	// are adopted by this source instead of registering new ones, e.g. to
+	// migrate a source to another namespace or cluster.
+	// +optional
+	AdoptWebhooksFrom *RetainedWebhooksRef `json:"adoptWebhooksFrom,omitempty"`
+
+	// AdapterMode determines how the source's receive adapter is run. One of
+	// "KnativeService", "Deployment" or "Shared". "KnativeService" runs the
+	// adapter as a Knative Service, "Deployment" runs it as a Deployment
+	// exposed by a Service, for clusters without Knative Serving, and
+	// "Shared" delivers the source's events through the receive adapter
+	// shared by all sources, instead of a dedicated one. Defaults to the
+	// mode configured in the controller.
+	// +optional
+	AdapterMode AdapterMode `json:"adapterMode,omitempty"`
+
+	// Exposure exposes the source's receive adapter outside of the cluster,
+	// so that GitLab can deliver events to it. Defaults to the exposure
+	// configured in the controller, if any.
+	// +optional
+	Exposure *AdapterExposure `json:"exposure,omitempty"`
+
+	// WebhookURL is the URL registered with GitLab hooks in place of the URL
+	// of the receive adapter, e.g. the URL of a custom edge proxy which
+	// forwards requests to the adapter. Takes precedence over Exposure.
+	// +optional
+	WebhookURL *apis.URL `json:"webhookURL,omitempty"`
+
+	// Adapter customizes the Pods of the source's receive adapter. It is
+	// merged into the Knative Service or Deployment generated for the
+	// adapter, and doesn't apply to the shared receive adapter.
+	// +optional
+	Adapter *AdapterTemplate `json:"adapter,omitempty"`
+}
+
+// Strategies used by GitLab to filter the branches of push events.
+// https://docs.gitlab.com/ee/user/project/integrations/webhooks.html#filter-push-events-by-branch
+const (
+	BranchFilterStrategyWildcard    = "wildcard"
+	BranchFilterStrategyRegex       = "regex"
+	BranchFilterStrategyAllBranches = "all_branches"
+)
+
+// WebhookDriftPolicy is a policy for handling drift between the configuration
+// of a source's hooks in GitLab and the source's spec.
+type WebhookDriftPolicy string
+
+// Supported webhook drift policies.
+const (
+	WebhookDriftPolicyCorrect WebhookDriftPolicy = "Correct"
+	WebhookDriftPolicyReport  WebhookDriftPolicy = "Report"
+)
+
+// DeletionPolicy is a policy for handling the hooks of a deleted source.
+type DeletionPolicy string
+
+// Supported deletion policies.
+const (
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// RetainedWebhooksRef identifies the hooks retained after the deletion of a
+// source.
+type RetainedWebhooksRef struct {
+	// Namespace of the deleted source.
+	Namespace string `json:"namespace"`
+
+	// Name of the deleted source.
+	Name string `json:"name"`
+
+	// Cluster is the identifier of the deleted source's cluster, as shown
+	// in the description of its retained hooks. Defaults to the cluster of
+	// this source.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// AdapterMode is the kind of workload which runs a receive adapter.
+type AdapterMode string
+
+// Supported adapter modes.
+const (
+	AdapterModeKnativeService AdapterMode = "KnativeService"
+	AdapterModeDeployment     AdapterMode = "Deployment"
+	AdapterModeShared         AdapterMode = "Shared"
+)
+
+// AdapterExposure describes how a receive adapter is exposed outside of the
+// cluster.
+type AdapterExposure struct {
+	// Type is the kind of object which exposes the receive adapter. One of
+	// "HTTPRoute" (Gateway API) or "Ingress".
+	Type ExposureType `json:"type"`
+
+	// Host is the public hostname of the receive adapter. Defaults to
+	// "<name>-<namespace>.<domain>", with the domain configured in the
+	// controller.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Path is the path prefix under which the receive adapter is exposed.
+	// Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Gateway is the Gateway which the HTTPRoute is attached to. Defaults
+	// to the Gateway configured in the controller. The Gateway is expected
+	// to terminate TLS for Host.
+	// +optional
+	Gateway *GatewayReference `json:"gateway,omitempty"`
+
+	// IngressClassName is the class of the Ingress. Defaults to the class
+	// configured in the controller, if any.
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// TLSSecretName is the name of the Secret holding the TLS certificate of
+	// Host, for Ingresses. Defaults to the Secret configured in the
+	// controller, if any. Without a Secret, the Ingress serves plain HTTP.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// AdapterTemplate describes customizations of the Pods of a receive adapter.
+type AdapterTemplate struct {
+	// Labels added to the adapter's Pods.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the adapter's Pods, e.g.
+	// "autoscaling.knative.dev/min-scale" to keep Knative Services warm.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Resources of the adapter's container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// SecurityContext of the adapter's container.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// PodSecurityContext of the adapter's Pods.
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// NodeSelector of the adapter's Pods.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Affinity of the adapter's Pods.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Tolerations of the adapter's Pods.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// ExposureType is the kind of object which exposes a receive adapter.
+type ExposureType string
+
+// Supported exposure types.
+const (
+	ExposureTypeHTTPRoute ExposureType = "HTTPRoute"
+	ExposureTypeIngress   ExposureType = "Ingress"
+)
+
+// GatewayReference is a reference to a Gateway API Gateway.
+type GatewayReference struct {
+	// Namespace of the Gateway. Defaults to the namespace of the source.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the Gateway.
+	Name string `json:"name"`
+}
+
+// SecretValueFromSource represents the source of a secret value
+type SecretValueFromSource struct {
+	// The Secret key to select from.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// GitLabSourceStatus defines the observed state of GitLabSource
+type GitLabSourceStatus struct {
+	// inherits duck/v1 SourceStatus, which currently provides:
+	// * ObservedGeneration - the 'Generation' of the Service that was last
+	//   processed by the controller.
+	// * Conditions - the latest available observations of a resource's current
+	//   state.
+	// * SinkURI - the current active sink URI that has been configured for the
+	//   Source.
+	duckv1.SourceStatus `json:",inline"`
+
+	// Webhooks registered with GitLab, one per project or group.
+	// +optional
+	Webhooks []WebhookStatus `json:"webhooks,omitempty"`
+
+	// SecretTokenSecretName is the name of the Secret containing the
+	// secret token generated by the controller, when the spec doesn't
+	// reference one.
+	// +optional
+	SecretTokenSecretName string `json:"secretTokenSecretName,omitempty"`
+
+	// SecretTokenRotation describes the ongoing rotation of the secret
+	// token, if any.
+	// +optional
+	SecretTokenRotation *SecretTokenRotationStatus `json:"secretTokenRotation,omitempty"`
+
+	// AccessTokenExpiresAt is the time at which the GitLab API token
+	// expires, as reported by GitLab. Unset for tokens which don't expire.
+	// +optional
+	AccessTokenExpiresAt *metav1.Time `json:"accessTokenExpiresAt,omitempty"`
+
+	// AccessTokenSecretName is the name of the Secret containing the
+	// project access tokens provisioned by the controller, when the spec
+	// doesn't reference an access token.
+	// +optional
+	AccessTokenSecretName string `json:"accessTokenSecretName,omitempty"`
+
+	// ProvisionedAccessTokens are the project access tokens provisioned by
+	// the controller, one per project.
+	// +optional
+	ProvisionedAccessTokens []ProvisionedAccessToken `json:"provisionedAccessTokens,omitempty"`
+}
+
+// ProvisionedAccessToken is a project access token provisioned by the
+// controller for a source.
+type ProvisionedAccessToken struct {
+	// ProjectURL is the URL of the GitLab project the token belongs to.
+	ProjectURL string `json:"projectUrl"`
+
+	// ProjectID is the ID of the GitLab project the token belongs to.
+	ProjectID int `json:"projectId"`
+
+	// TokenID is the ID of the token in GitLab, which changes whenever the
+	// token is rotated.
+	TokenID int `json:"tokenId"`
+
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// SecretTokenRotationStatus describes the rotation of a source's secret token,
+// during which the receive adapter accepts both the previous and the new
+// token.
+type SecretTokenRotationStatus struct {
+	// PreviousSecretName is the name of the Secret containing the token
+	// previously applied to the source's hooks.
+	PreviousSecretName string `json:"previousSecretName"`
+
+	// HooksUpdatedTime is the time at which all of the source's hooks were
+	// updated with the new token. The previous token is accepted for a
+	// grace period starting at this time.
+	// +optional
+	HooksUpdatedTime *metav1.Time `json:"hooksUpdatedTime,omitempty"`
+}
+
+// WebhookStatus describes a hook registered with a GitLab project or group.
+type WebhookStatus struct {
+	// ProjectURL is the url of the GitLab project the hook is registered
+	// with. Mutually exclusive with GroupURL.
+	// +optional
+	ProjectURL string `json:"projectUrl,omitempty"`
+
+	// GroupURL is the url of the GitLab group the hook is registered with.
+	// Mutually exclusive with ProjectURL.
+	// +optional
+	GroupURL string `json:"groupUrl,omitempty"`
+
+	// ID of the hook.
+	ID int `json:"id"`
+
+	// ID of the GitLab project or group the hook belongs to.
+	// +optional
+	OwnerID int `json:"ownerId,omitempty"`
+
+	// PathWithNamespace is the full path of the GitLab project or group
+	// the hook belongs to.
+	// +optional
+	PathWithNamespace string `json:"pathWithNamespace,omitempty"`
+
+	// URL the hook delivers events to, as observed in GitLab.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// EventTypes is the set of webhooks enabled on the hook, as observed
+	// in GitLab.
+	// +optional
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// SSLVerify reports whether GitLab verifies the SSL certificate of
+	// the hook's URL.
+	// +optional
+	SSLVerify bool `json:"sslVerify,omitempty"`
+
+	// AlertStatus is the state of the hook reported by GitLab, which
+	// disables hooks temporarily or permanently after failed deliveries.
+	// One of "executable", "temporarily_disabled" or "disabled".
+	// +optional
+	AlertStatus string `json:"alertStatus,omitempty"`
+
+	// DisabledUntil is the time until which GitLab temporarily disabled
+	// the hook.
+	// +optional
+	DisabledUntil *metav1.Time `json:"disabledUntil,omitempty"`
+
+	// ObservedConfigHash is a hash of the configuration of the hook
+	// observed in GitLab.
+	// +optional
+	ObservedConfigHash string `json:"observedConfigHash,omitempty"`
+
+	// SecretTokenHash is an HMAC of the secret token last applied to the
+	// hook by the controller, keyed by a secret of the controller. GitLab
+	// never returns secret tokens, so this hash is used to detect changes
+	// to the token referenced by the source.
+	// +optional
+	SecretTokenHash string `json:"secretTokenHash,omitempty"`
+}
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitLabSource is the Schema for the gitlabsources API.
+type GitLabSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitLabSourceSpec   `json:"spec,omitempty"`
+	Status GitLabSourceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitLabSourceList contains a list of GitLabSource.
+type GitLabSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitLabSource `json:"items"`
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate GitLab source object fields
+func (s *GitLabSource) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.Spec.Validate(ctx).ViaField("spec")
+
+	if apis.IsInUpdate(ctx) {
+		original := apis.GetBaseline(ctx).(*GitLabSource)
+		errs = errs.Also(s.Spec.validateUpdate(&original.Spec).ViaField("spec"))
+	}
+
+	return errs
+}
+
+// Validate GitLab source Spec object fields
+func (s *GitLabSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	// Validate sink
+	if fieldErr := s.Sink.Validate(ctx); fieldErr != nil {
+		errs = errs.Also(fieldErr.ViaField("sink"))
+	}
+
+	// Validate event origin
+	if s.GroupURL != "" {
+		if s.ProjectURL != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf("groupUrl", "projectUrl"))
+		}
+		if len(s.ProjectURLs) > 0 {
+			errs = errs.Also(apis.ErrMultipleOneOf("groupUrl", "projectUrls"))
+		}
+		errs = errs.Also(validateGitLabURL(s.GroupURL, false).ViaField("groupUrl"))
+
+	} else {
+		if s.ProjectURL == "" && len(s.ProjectURLs) == 0 {
+			errs = errs.Also(apis.ErrMissingOneOf("projectUrl", "projectUrls", "groupUrl"))
+		}
+		if s.ProjectURL != "" {
+			errs = errs.Also(validateGitLabURL(s.ProjectURL, true).ViaField("projectUrl"))
+		}
+		for i, u := range s.ProjectURLs {
+			errs = errs.Also(validateGitLabURL(u, true).ViaFieldIndex("projectUrls", i))
+		}
+	}
+
+	// All projects must be hosted on the same GitLab instance, since they
+	// are accessed with the same API token
+	if targets := s.webhookTargets(); len(targets) > 1 {
+		instance := gitLabInstance(targets[0])
+		for i, u := range s.ProjectURLs {
+			if inst := gitLabInstance(u); inst != "" && instance != "" && inst != instance {
+				errs = errs.Also(apis.ErrInvalidValue(u, apis.CurrentField,
+					"all projects must be hosted on the same GitLab instance").ViaFieldIndex("projectUrls", i))
+			}
+		}
+	}
+
+	// Validate webhooks
+	if len(s.EventTypes) == 0 {
+		errs = errs.Also(apis.ErrMissingField("eventTypes"))
+	}
+	for i, hook := range s.EventTypes {
+		if reason, isUnsupported := unsupportedWebhooks[hook]; isUnsupported {
+			errs = errs.Also(apis.ErrInvalidValue(hook, apis.CurrentField,
+				"not supported, "+reason).ViaFieldIndex("eventTypes", i))
+			continue
+		}
+		if _, isKnown := eventTypesByWebhook[hook]; !isKnown {
+			errs = errs.Also(apis.ErrInvalidArrayValue(hook, "eventTypes", i))
+			continue
+		}
+		if _, isGroupOnly := groupOnlyWebhooks[hook]; isGroupOnly && s.GroupURL == "" {
+			errs = errs.Also(apis.ErrInvalidValue(hook, apis.CurrentField,
+				"only available on group sources").ViaFieldIndex("eventTypes", i))
+		}
+	}
+
+	// Validate secrets. Access tokens are only provisioned for projects.
+	switch {
+	case s.AccessToken != nil && (s.AccessToken.SecretKeyRef != nil || s.GroupURL != ""):
+		errs = errs.Also(s.AccessToken.Validate(ctx).ViaField("accessToken"))
+	case s.GroupURL != "":
+		errs = errs.Also(apis.ErrMissingField("accessToken"))
+	}
+	if s.SecretToken != nil {
+		errs = errs.Also(s.SecretToken.Validate(ctx).ViaField("secretToken"))
+	}
+
+	// Validate push events branch filter
+	switch s.BranchFilterStrategy {
+	case "", BranchFilterStrategyWildcard, BranchFilterStrategyAllBranches:
+	case BranchFilterStrategyRegex:
+		if _, err := regexp.Compile(s.PushEventsBranchFilter); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(s.PushEventsBranchFilter, "pushEventsBranchFilter", err.Error()))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.BranchFilterStrategy, "branchFilterStrategy"))
+	}
+
+	switch s.WebhookDriftPolicy {
+	case "", WebhookDriftPolicyCorrect, WebhookDriftPolicyReport:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.WebhookDriftPolicy, "webhookDriftPolicy"))
+	}
+
+	switch s.DeletionPolicy {
+	case "", DeletionPolicyDelete, DeletionPolicyRetain:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.DeletionPolicy, "deletionPolicy"))
+	}
+
+	if ref := s.AdoptWebhooksFrom; ref != nil {
+		if ref.Namespace == "" {
+			errs = errs.Also(apis.ErrMissingField("namespace").ViaField("adoptWebhooksFrom"))
+		}
+		if ref.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaField("adoptWebhooksFrom"))
+		}
+	}
+
+	switch s.AdapterMode {
+	case "", AdapterModeKnativeService, AdapterModeDeployment, AdapterModeShared:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.AdapterMode, "adapterMode"))
+	}
+
+	if s.Exposure != nil {
+		if s.AdapterMode == AdapterModeShared {
+			errs = errs.Also(apis.ErrGeneric("the shared receive adapter can not be exposed per source", "exposure"))
+		}
+		errs = errs.Also(s.Exposure.Validate().ViaField("exposure"))
+	}
+
+	if s.Adapter != nil && s.AdapterMode == AdapterModeShared {
+		errs = errs.Also(apis.ErrGeneric("the shared receive adapter can not be customized per source", "adapter"))
+	}
+
+	if s.WebhookURL != nil {
+		if s.WebhookURL.Host == "" || (s.WebhookURL.Scheme != "http" && s.WebhookURL.Scheme != "https") {
+			errs = errs.Also(apis.ErrInvalidValue(s.WebhookURL.String(), "webhookURL",
+				"must be an absolute http or https URL"))
+		}
+	}
+
+	return errs
+}
+
+// Validate validates the exposure of a receive adapter.
+func (e *AdapterExposure) Validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch e.Type {
+	case ExposureTypeHTTPRoute:
+		if e.TLSSecretName != "" {
+			errs = errs.Also(apis.ErrDisallowedFields("tlsSecretName"))
+		}
+		if e.IngressClassName != "" {
+			errs = errs.Also(apis.ErrDisallowedFields("ingressClassName"))
+		}
+		if e.Gateway != nil && e.Gateway.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("gateway.name"))
+		}
+	case ExposureTypeIngress:
+		if e.Gateway != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("gateway"))
+		}
+	case "":
+		errs = errs.Also(apis.ErrMissingField("type"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(e.Type, "type"))
+	}
+
+	if e.Path != "" && !strings.HasPrefix(e.Path, "/") {
+		errs = errs.Also(apis.ErrInvalidValue(e.Path, "path", "must start with a slash"))
+	}
+
+	return errs
+}
+
+// validateUpdate validates the changes applied to the given original spec.
+func (s *GitLabSourceSpec) validateUpdate(original *GitLabSourceSpec) *apis.FieldError {
+	// Existing hooks are deleted using the API token of the updated spec, so
+	// moving the source to a different GitLab instance would orphan them.
+	origTargets, targets := original.webhookTargets(), s.webhookTargets()
+	if len(origTargets) == 0 || len(targets) == 0 {
+		return nil
+	}
+
+	if origInst, inst := gitLabInstance(origTargets[0]), gitLabInstance(targets[0]); origInst != inst {
+		return &apis.FieldError{
+			Message: "Immutable fields changed (-old +new)",
+			Paths:   []string{"projectUrl", "projectUrls", "groupUrl"},
+			Details: "the GitLab instance of the source can not be changed: -" + origInst + " +" + inst,
+		}
+	}
+
+	return nil
+}
+
+// webhookTargets returns the URLs of the GitLab projects or group declared in
+// the spec.
+func (s *GitLabSourceSpec) webhookTargets() []string {
+	return (&GitLabSource{Spec: *s}).WebhookTargets()
+}
+
+// Validate the reference to a secret value.
+func (s *SecretValueFromSource) Validate(ctx context.Context) *apis.FieldError {
+	if s.SecretKeyRef == nil {
+		return apis.ErrMissingField("secretKeyRef")
+	}
+
+	var errs *apis.FieldError
+	if s.SecretKeyRef.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKeyRef.name"))
+	}
+	if s.SecretKeyRef.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKeyRef.key"))
+	}
+	return errs
+}
+
+// validateGitLabURL validates the URL of a GitLab project or group.
+// A project URL must contain at least the path of a namespace and the name of
+// the project, e.g. "https://gitlab.example.com/mygroup/myproject".
+func validateGitLabURL(rawURL string, isProject bool) *apis.FieldError {
+	u, errs := parseGitLabURL(rawURL)
+	if errs != nil {
+		return errs
+	}
+
+	minSegments, kind := 1, "group"
+	if isProject {
+		minSegments, kind = 2, "project"
+	}
+
+	segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(segments) < minSegments {
+		return apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL must contain the path of a "+kind)
+	}
+	for _, seg := range segments {
+		if seg == "" {
+			return apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL must contain the path of a "+kind)
+		}
+	}
+
+	return nil
+}
+
+// parseGitLabURL parses the URL of a GitLab instance or of one of its
+// resources, and validates the components which are common to all those URLs.
+func parseGitLabURL(rawURL string) (*url.URL, *apis.FieldError) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField, err.Error())
+	}
+
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL scheme must be http or https")
+	case u.Host == "":
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField, "the URL must contain a host")
+	case u.User != nil || u.RawQuery != "" || u.Fragment != "":
+		return nil, apis.ErrInvalidValue(rawURL, apis.CurrentField,
+			"the URL must not contain any user information, query or fragment")
+	}
+
+	return u, nil
+}
+
+// gitLabInstance returns the scheme and host of the GitLab instance hosting
+// the project or group with the given URL, or an empty string if the URL is
+// invalid.
+func gitLabInstance(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
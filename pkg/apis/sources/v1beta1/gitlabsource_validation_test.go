@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitLabSourceValidation(t *testing.T) {
+	testCases := map[string]struct {
+		spec func(*GitLabSourceSpec)
+		want *apis.FieldError
+	}{
+		"valid": {
+			spec: func(*GitLabSourceSpec) {},
+		},
+		"empty sink": {
+			spec: func(s *GitLabSourceSpec) {
+				s.Sink = duckv1.Destination{}
+			},
+			want: apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("spec.sink"),
+		},
+		"no project or group": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+			},
+			want: apis.ErrMissingOneOf("projectUrl", "projectUrls", "groupUrl").ViaField("spec"),
+		},
+		"group and projects": {
+			spec: func(s *GitLabSourceSpec) {
+				s.GroupURL = "https://gitlab.example.com/mygroup"
+				s.ProjectURL = ""
+				s.ProjectURLs = []string{"https://gitlab.example.com/mygroup/myproject"}
+			},
+			want: apis.ErrMultipleOneOf("groupUrl", "projectUrls").ViaField("spec"),
+		},
+		"project URL without scheme": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = "gitlab.example.com/mygroup/myproject"
+			},
+			want: apis.ErrInvalidValue("gitlab.example.com/mygroup/myproject", "spec.projectUrl",
+				"the URL scheme must be http or https"),
+		},
+		"project URL without host": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = "https:///mygroup/myproject"
+			},
+			want: apis.ErrInvalidValue("https:///mygroup/myproject", "spec.projectUrl",
+				"the URL must contain a host"),
+		},
+		"project URL without project path": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURLs = []string{"https://gitlab.example.com/mygroup"}
+			},
+			want: apis.ErrInvalidValue("https://gitlab.example.com/mygroup", "spec.projectUrls[0]",
+				"the URL must contain the path of a project"),
+		},
+		"projects on different instances": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURLs = []string{"https://gitlab.com/mygroup/myproject"}
+			},
+			want: apis.ErrInvalidValue("https://gitlab.com/mygroup/myproject", "spec.projectUrls[0]",
+				"all projects must be hosted on the same GitLab instance"),
+		},
+		"group URL without group path": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+				s.GroupURL = "https://gitlab.example.com"
+			},
+			want: apis.ErrInvalidValue("https://gitlab.example.com", "spec.groupUrl",
+				"the URL must contain the path of a group"),
+		},
+		"no event type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = nil
+			},
+			want: apis.ErrMissingField("spec.eventTypes"),
+		},
+		"unknown event type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = []string{GitLabWebhookPush, "unknown_events"}
+			},
+			want: apis.ErrInvalidArrayValue("unknown_events", "spec.eventTypes", 1),
+		},
+		"unsupported event type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = []string{GitLabWebhookPush, GitLabWebhookVulnerability}
+			},
+			want: apis.ErrInvalidValue(GitLabWebhookVulnerability, "spec.eventTypes[1]",
+				"not supported, vulnerability events can not be parsed by receive adapters"),
+		},
+		"group event type on project": {
+			spec: func(s *GitLabSourceSpec) {
+				s.EventTypes = []string{GitLabWebhookMember}
+			},
+			want: apis.ErrInvalidValue(GitLabWebhookMember, "spec.eventTypes[0]", "only available on group sources"),
+		},
+		"incomplete secret refs": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AccessToken.SecretKeyRef.Key = ""
+				s.SecretToken.SecretKeyRef.Key = ""
+			},
+			want: apis.ErrMissingField("spec.accessToken.secretKeyRef.key", "spec.secretToken.secretKeyRef.key"),
+		},
+		"provisioned access token": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AccessToken = nil
+			},
+		},
+		"empty access token": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AccessToken.SecretKeyRef = nil
+			},
+		},
+		"no access token on group": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+				s.GroupURL = "https://gitlab.example.com/mygroup"
+				s.AccessToken = nil
+			},
+			want: apis.ErrMissingField("spec.accessToken"),
+		},
+		"empty access token on group": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+				s.GroupURL = "https://gitlab.example.com/mygroup"
+				s.AccessToken.SecretKeyRef = nil
+			},
+			want: apis.ErrMissingField("spec.accessToken.secretKeyRef"),
+		},
+		"generated secret token": {
+			spec: func(s *GitLabSourceSpec) {
+				s.SecretToken = nil
+			},
+		},
+		"unknown branch filter strategy": {
+			spec: func(s *GitLabSourceSpec) {
+				s.BranchFilterStrategy = "glob"
+			},
+			want: apis.ErrInvalidValue("glob", "spec.branchFilterStrategy"),
+		},
+		"invalid branch filter regex": {
+			spec: func(s *GitLabSourceSpec) {
+				s.PushEventsBranchFilter = "release/("
+				s.BranchFilterStrategy = BranchFilterStrategyRegex
+			},
+			want: apis.ErrInvalidValue("release/(", "spec.pushEventsBranchFilter",
+				"error parsing regexp: missing closing ): `release/(`"),
+		},
+		"unknown webhook drift policy": {
+			spec: func(s *GitLabSourceSpec) {
+				s.WebhookDriftPolicy = "Ignore"
+			},
+			want: apis.ErrInvalidValue("Ignore", "spec.webhookDriftPolicy"),
+		},
+		"unknown deletion policy": {
+			spec: func(s *GitLabSourceSpec) {
+				s.DeletionPolicy = "Orphan"
+			},
+			want: apis.ErrInvalidValue("Orphan", "spec.deletionPolicy"),
+		},
+		"adopted webhooks without name": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AdoptWebhooksFrom = &RetainedWebhooksRef{Namespace: "default"}
+			},
+			want: apis.ErrMissingField("spec.adoptWebhooksFrom.name"),
+		},
+		"unknown adapter mode": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AdapterMode = "StatefulSet"
+			},
+			want: apis.ErrInvalidValue("StatefulSet", "spec.adapterMode"),
+		},
+		"exposure without type": {
+			spec: func(s *GitLabSourceSpec) {
+				s.Exposure = &AdapterExposure{Host: "gitlab.example.com"}
+			},
+			want: apis.ErrMissingField("spec.exposure.type"),
+		},
+		"gateway of an Ingress exposure": {
+			spec: func(s *GitLabSourceSpec) {
+				s.Exposure = &AdapterExposure{
+					Type:    ExposureTypeIngress,
+					Gateway: &GatewayReference{Name: "my-gateway"},
+				}
+			},
+			want: apis.ErrDisallowedFields("spec.exposure.gateway"),
+		},
+		"exposure of the shared adapter": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AdapterMode = AdapterModeShared
+				s.Exposure = &AdapterExposure{Type: ExposureTypeHTTPRoute}
+			},
+			want: apis.ErrGeneric("the shared receive adapter can not be exposed per source", "spec.exposure"),
+		},
+		"customization of the shared adapter": {
+			spec: func(s *GitLabSourceSpec) {
+				s.AdapterMode = AdapterModeShared
+				s.Adapter = &AdapterTemplate{Labels: map[string]string{"team": "ci"}}
+			},
+			want: apis.ErrGeneric("the shared receive adapter can not be customized per source", "spec.adapter"),
+		},
+		"relative webhook URL": {
+			spec: func(s *GitLabSourceSpec) {
+				s.WebhookURL = &apis.URL{Path: "/hooks"}
+			},
+			want: apis.ErrInvalidValue("/hooks", "spec.webhookURL", "must be an absolute http or https URL"),
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			src := newValidGitLabSource()
+			tc.spec(&src.Spec)
+
+			got := src.Validate(context.Background())
+			if diff := cmp.Diff(tc.want.Error(), got.Error()); diff != "" {
+				t.Errorf("%s: validate (-want, +got) = %v", n, diff)
+			}
+		})
+	}
+}
+
+func TestGitLabSourceUpdateValidation(t *testing.T) {
+	testCases := map[string]struct {
+		spec    func(*GitLabSourceSpec)
+		wantErr bool
+	}{
+		"project added": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURLs = []string{"https://gitlab.example.com/mygroup/otherproject"}
+			},
+		},
+		"project replaced by group": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = ""
+				s.GroupURL = "https://gitlab.example.com/mygroup"
+			},
+		},
+		"instance changed": {
+			spec: func(s *GitLabSourceSpec) {
+				s.ProjectURL = "https://gitlab.com/mygroup/myproject"
+			},
+			wantErr: true,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			orig := newValidGitLabSource()
+			src := newValidGitLabSource()
+			tc.spec(&src.Spec)
+
+			ctx := apis.WithinUpdate(context.Background(), orig)
+
+			if got := src.Validate(ctx); (got != nil) != tc.wantErr {
+				t.Errorf("Unexpected validation result: %v", got)
+			}
+		})
+	}
+}
+
+// newValidGitLabSource returns a GitLabSource with a valid spec.
+func newValidGitLabSource() *GitLabSource {
+	return &GitLabSource{
+		Spec: GitLabSourceSpec{
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{URI: apis.HTTP("sink.example.com")},
+			},
+			ProjectURL: "https://gitlab.example.com/mygroup/myproject",
+			EventTypes: []string{GitLabWebhookPush, GitLabWebhookIssues},
+			AccessToken: &SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "accessToken",
+				},
+			},
+			SecretToken: &SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlabsecret"},
+					Key:                  "secretToken",
+				},
+			},
+		},
+	}
+}
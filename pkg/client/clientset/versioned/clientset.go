@@ -26,20 +26,26 @@ import (
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
 	bindingsv1alpha1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/bindings/v1alpha1"
+	bindingsv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/bindings/v1beta1"
 	sourcesv1alpha1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1alpha1"
+	sourcesv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1beta1"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	BindingsV1alpha1() bindingsv1alpha1.BindingsV1alpha1Interface
+	BindingsV1beta1() bindingsv1beta1.BindingsV1beta1Interface
 	SourcesV1alpha1() sourcesv1alpha1.SourcesV1alpha1Interface
+	SourcesV1beta1() sourcesv1beta1.SourcesV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	bindingsV1alpha1 *bindingsv1alpha1.BindingsV1alpha1Client
+	bindingsV1beta1  *bindingsv1beta1.BindingsV1beta1Client
 	sourcesV1alpha1  *sourcesv1alpha1.SourcesV1alpha1Client
+	sourcesV1beta1   *sourcesv1beta1.SourcesV1beta1Client
 }
 
 // BindingsV1alpha1 retrieves the BindingsV1alpha1Client
@@ -47,11 +53,21 @@ func (c *Clientset) BindingsV1alpha1() bindingsv1alpha1.BindingsV1alpha1Interfac
 	return c.bindingsV1alpha1
 }
 
+// BindingsV1beta1 retrieves the BindingsV1beta1Client
+func (c *Clientset) BindingsV1beta1() bindingsv1beta1.BindingsV1beta1Interface {
+	return c.bindingsV1beta1
+}
+
 // SourcesV1alpha1 retrieves the SourcesV1alpha1Client
 func (c *Clientset) SourcesV1alpha1() sourcesv1alpha1.SourcesV1alpha1Interface {
 	return c.sourcesV1alpha1
 }
 
+// SourcesV1beta1 retrieves the SourcesV1beta1Client
+func (c *Clientset) SourcesV1beta1() sourcesv1beta1.SourcesV1beta1Interface {
+	return c.sourcesV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -100,10 +116,18 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.bindingsV1beta1, err = bindingsv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.sourcesV1alpha1, err = sourcesv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.sourcesV1beta1, err = sourcesv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -126,7 +150,9 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.bindingsV1alpha1 = bindingsv1alpha1.New(c)
+	cs.bindingsV1beta1 = bindingsv1beta1.New(c)
 	cs.sourcesV1alpha1 = sourcesv1alpha1.New(c)
+	cs.sourcesV1beta1 = sourcesv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -27,8 +27,12 @@ import (
 	clientset "knative.dev/eventing-gitlab/pkg/client/clientset/versioned"
 	bindingsv1alpha1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/bindings/v1alpha1"
 	fakebindingsv1alpha1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/bindings/v1alpha1/fake"
+	bindingsv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/bindings/v1beta1"
+	fakebindingsv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/bindings/v1beta1/fake"
 	sourcesv1alpha1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1alpha1"
 	fakesourcesv1alpha1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1alpha1/fake"
+	sourcesv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1beta1"
+	fakesourcesv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1beta1/fake"
 )
 
 // NewSimpleClientset returns a clientset that will respond with the provided objects.
@@ -86,7 +90,17 @@ func (c *Clientset) BindingsV1alpha1() bindingsv1alpha1.BindingsV1alpha1Interfac
 	return &fakebindingsv1alpha1.FakeBindingsV1alpha1{Fake: &c.Fake}
 }
 
+// BindingsV1beta1 retrieves the BindingsV1beta1Client
+func (c *Clientset) BindingsV1beta1() bindingsv1beta1.BindingsV1beta1Interface {
+	return &fakebindingsv1beta1.FakeBindingsV1beta1{Fake: &c.Fake}
+}
+
 // SourcesV1alpha1 retrieves the SourcesV1alpha1Client
 func (c *Clientset) SourcesV1alpha1() sourcesv1alpha1.SourcesV1alpha1Interface {
 	return &fakesourcesv1alpha1.FakeSourcesV1alpha1{Fake: &c.Fake}
 }
+
+// SourcesV1beta1 retrieves the SourcesV1beta1Client
+func (c *Clientset) SourcesV1beta1() sourcesv1beta1.SourcesV1beta1Interface {
+	return &fakesourcesv1beta1.FakeSourcesV1beta1{Fake: &c.Fake}
+}
@@ -25,7 +25,9 @@ import (
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	bindingsv1alpha1 "knative.dev/eventing-gitlab/pkg/apis/bindings/v1alpha1"
+	bindingsv1beta1 "knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
 	sourcesv1alpha1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
 )
 
 var Scheme = runtime.NewScheme()
@@ -33,7 +35,9 @@ var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	bindingsv1alpha1.AddToScheme,
+	bindingsv1beta1.AddToScheme,
 	sourcesv1alpha1.AddToScheme,
+	sourcesv1beta1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
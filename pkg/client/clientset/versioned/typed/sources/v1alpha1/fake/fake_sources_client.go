@@ -32,6 +32,10 @@ func (c *FakeSourcesV1alpha1) GitLabSources(namespace string) v1alpha1.GitLabSou
 	return &FakeGitLabSources{c, namespace}
 }
 
+func (c *FakeSourcesV1alpha1) GitLabSystemSources(namespace string) v1alpha1.GitLabSystemSourceInterface {
+	return &FakeGitLabSystemSources{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSourcesV1alpha1) RESTClient() rest.Interface {
@@ -29,6 +29,7 @@ import (
 type SourcesV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	GitLabSourcesGetter
+	GitLabSystemSourcesGetter
 }
 
 // SourcesV1alpha1Client is used to interact with features provided by the sources.knative.dev group.
@@ -40,6 +41,10 @@ func (c *SourcesV1alpha1Client) GitLabSources(namespace string) GitLabSourceInte
 	return newGitLabSources(c, namespace)
 }
 
+func (c *SourcesV1alpha1Client) GitLabSystemSources(namespace string) GitLabSystemSourceInterface {
+	return newGitLabSystemSources(c, namespace)
+}
+
 // NewForConfig creates a new SourcesV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
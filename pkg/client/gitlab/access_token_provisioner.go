@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// ErrForeignInstance indicates that a project doesn't belong to the GitLab
+// instance which access tokens are provisioned on. The credentials of the
+// provisioner are never sent to other instances.
+var ErrForeignInstance = errors.New("the project doesn't belong to the GitLab instance which access tokens are provisioned on")
+
+// ProjectAccessToken is a project access token provisioned for managing the
+// hooks of a GitLab project.
+type ProjectAccessToken struct {
+	ID        int
+	ProjectID int
+	// Value of the token. Only reported by GitLab when the token is
+	// created or rotated.
+	Token     string
+	ExpiresAt time.Time
+}
+
+// AccessTokenProvisioner provisions project access tokens for managing the
+// hooks of GitLab projects, using the API token of a user who can manage
+// the access tokens of these projects, e.g. the owner of their group.
+type AccessTokenProvisioner interface {
+	// Create creates an access token with the given name for the project
+	// with the given URL.
+	Create(projectURL, name string, expiresAt time.Time) (*ProjectAccessToken, error)
+	// Rotate replaces an access token of the given project with a new one.
+	Rotate(projectID, tokenID int, expiresAt time.Time) (*ProjectAccessToken, error)
+	// Revoke revokes an access token of the given project. Tokens which
+	// no longer exist are considered revoked.
+	Revoke(projectID, tokenID int) error
+	// List returns the active access tokens with the given name of the
+	// project with the given URL, without their value.
+	List(projectURL, name string) ([]*ProjectAccessToken, error)
+}
+
+// NewAccessTokenProvisioner returns an AccessTokenProvisioner for the GitLab
+// instance with the given base URL, which authenticates with the API token
+// referenced by ownerTokenRef. This token is read on every call, so that it
+// can be replaced without restarting the controller.
+func NewAccessTokenProvisioner(sg secret.Getter, baseURL string,
+	ownerTokenRef *corev1.SecretKeySelector) AccessTokenProvisioner {
+
+	return &accessTokenProvisioner{
+		sg:            sg,
+		baseURL:       baseURL,
+		ownerTokenRef: ownerTokenRef,
+	}
+}
+
+// accessTokenProvisioner is the default implementation of
+// AccessTokenProvisioner.
+type accessTokenProvisioner struct {
+	sg            secret.Getter
+	baseURL       string
+	ownerTokenRef *corev1.SecretKeySelector
+}
+
+// accessTokenProvisioner implements AccessTokenProvisioner.
+var _ AccessTokenProvisioner = (*accessTokenProvisioner)(nil)
+
+// Create implements AccessTokenProvisioner.
+// The token is granted the "api" scope and the Maintainer role, which are the
+// minimum required for managing the hooks of the project.
+func (p *accessTokenProvisioner) Create(projectURL, name string, expiresAt time.Time) (*ProjectAccessToken, error) {
+	cli, proj, err := p.project(projectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tok, _, err := cli.ProjectAccessTokens.CreateProjectAccessToken(proj.ID, &gitlab.CreateProjectAccessTokenOptions{
+		Name:        gitlab.Ptr(name),
+		Description: gitlab.Ptr("Manages the webhook of a Knative GitLabSource. Rotated and revoked automatically."),
+		Scopes:      gitlab.Ptr([]string{APIScope}),
+		AccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions),
+		ExpiresAt:   isoTime(expiresAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating access token for project %q: %w", proj.PathWithNamespace, err)
+	}
+
+	return toProjectAccessToken(proj.ID, tok), nil
+}
+
+// List implements AccessTokenProvisioner.
+func (p *accessTokenProvisioner) List(projectURL, name string) ([]*ProjectAccessToken, error) {
+	cli, proj, err := p.project(projectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var toks []*ProjectAccessToken
+
+	err = listAll(func(lopt *gitlab.ListOptions) (*gitlab.Response, error) {
+		page, resp, err := cli.ProjectAccessTokens.ListProjectAccessTokens(proj.ID, &gitlab.ListProjectAccessTokensOptions{
+			ListOptions: *lopt,
+			State:       gitlab.Ptr("active"),
+		})
+		for _, tok := range page {
+			if tok.Name == name {
+				toks = append(toks, toProjectAccessToken(proj.ID, tok))
+			}
+		}
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing access tokens of project %q: %w", proj.PathWithNamespace, err)
+	}
+
+	return toks, nil
+}
+
+// Rotate implements AccessTokenProvisioner.
+func (p *accessTokenProvisioner) Rotate(projectID, tokenID int, expiresAt time.Time) (*ProjectAccessToken, error) {
+	cli, err := p.client()
+	if err != nil {
+		return nil, err
+	}
+
+	tok, _, err := cli.ProjectAccessTokens.RotateProjectAccessToken(projectID, tokenID, &gitlab.RotateProjectAccessTokenOptions{
+		ExpiresAt: isoTime(expiresAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rotating access token %d of project %d: %w", tokenID, projectID, err)
+	}
+
+	return toProjectAccessToken(projectID, tok), nil
+}
+
+// Revoke implements AccessTokenProvisioner.
+func (p *accessTokenProvisioner) Revoke(projectID, tokenID int) error {
+	cli, err := p.client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.ProjectAccessTokens.RevokeProjectAccessToken(projectID, tokenID)
+	if err != nil && !isNotFound(resp, err) {
+		return fmt.Errorf("revoking access token %d of project %d: %w", tokenID, projectID, err)
+	}
+
+	return nil
+}
+
+// project returns a GitLab API client authenticated with the provisioner's API
+// token, together with the project with the given URL. The ID of the project
+// is resolved first, because GitLab doesn't report it in the attributes of
+// access tokens.
+func (p *accessTokenProvisioner) project(projectURL string) (*gitlab.Client, *gitlab.Project, error) {
+	baseURL, path, err := splitGitLabURL(projectURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading components from the given project URL: %w", err)
+	}
+	if strings.TrimSuffix(baseURL, "/") != strings.TrimSuffix(p.baseURL, "/") {
+		return nil, nil, fmt.Errorf("%w: %s", ErrForeignInstance, projectURL)
+	}
+
+	cli, err := p.client()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proj, _, err := cli.Projects.GetProject(path, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting project %q: %w", path, err)
+	}
+
+	return cli, proj, nil
+}
+
+// client returns a GitLab API client authenticated with the provisioner's API
+// token.
+func (p *accessTokenProvisioner) client() (*gitlab.Client, error) {
+	cli, _, _, err := newClientWithSecrets(p.sg, p.baseURL, p.ownerTokenRef, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating client for provisioning access tokens: %w", err)
+	}
+	return cli, nil
+}
+
+// toProjectAccessToken converts a token reported by GitLab to a
+// ProjectAccessToken.
+func toProjectAccessToken(projectID int, tok *gitlab.ProjectAccessToken) *ProjectAccessToken {
+	pat := &ProjectAccessToken{
+		ID:        tok.ID,
+		ProjectID: projectID,
+		Token:     tok.Token,
+	}
+	if tok.ExpiresAt != nil {
+		pat.ExpiresAt = time.Time(*tok.ExpiresAt)
+	}
+	return pat
+}
+
+// isoTime returns the date of the given time, in a form suitable for usage in
+// the options of GitLab API calls. GitLab access tokens expire at the start
+// of their expiration date.
+func isoTime(t time.Time) *gitlab.ISOTime {
+	d := gitlab.ISOTime(t.UTC().Truncate(24 * time.Hour))
+	return &d
+}
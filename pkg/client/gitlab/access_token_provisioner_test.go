@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenProvisionerCreate(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/mygroup%2Fmyproject":
+			writeJSON(t, w, http.StatusOK, map[string]any{"id": 7, "path_with_namespace": "mygroup/myproject"})
+
+		case "/api/v4/projects/7/access_tokens":
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			body := requestBody(t, r)
+			assert.Equal(t, "knative-source", body["name"])
+			assert.Equal(t, []any{APIScope}, body["scopes"])
+			assert.Equal(t, float64(40), body["access_level"], "Maintainer role")
+			// tokens expire at the start of their expiration date
+			assert.Equal(t, "2026-11-16", body["expires_at"])
+
+			writeJSON(t, w, http.StatusCreated, map[string]any{
+				"id":         9,
+				"name":       "knative-source",
+				"token":      "glpat-new",
+				"expires_at": "2026-11-16",
+			})
+
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.EscapedPath())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	p := NewAccessTokenProvisioner(testSecretGetter, srv.URL, testSecretKeyRef("access-token"))
+
+	tok, err := p.Create(srv.URL+"/mygroup/myproject", "knative-source", time.Date(2026, 11, 16, 18, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectAccessToken{
+		ID:        9,
+		ProjectID: 7,
+		Token:     "glpat-new",
+		ExpiresAt: time.Date(2026, 11, 16, 0, 0, 0, 0, time.UTC),
+	}, tok)
+}
+
+func TestAccessTokenProvisionerList(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/mygroup%2Fmyproject":
+			writeJSON(t, w, http.StatusOK, map[string]any{"id": 7, "path_with_namespace": "mygroup/myproject"})
+
+		case "/api/v4/projects/7/access_tokens":
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "active", r.URL.Query().Get("state"))
+
+			// tokens are listed in two pages
+			if r.URL.Query().Get("page") == "" {
+				w.Header().Set("X-Next-Page", "2")
+				writeJSON(t, w, http.StatusOK, []map[string]any{
+					{"id": 1, "name": "knative-source", "expires_at": "2026-11-16"},
+					{"id": 2, "name": "other-token"},
+				})
+				return
+			}
+			writeJSON(t, w, http.StatusOK, []map[string]any{
+				{"id": 3, "name": "knative-source", "expires_at": "2026-12-16"},
+			})
+
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.EscapedPath())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	p := NewAccessTokenProvisioner(testSecretGetter, srv.URL, testSecretKeyRef("access-token"))
+
+	toks, err := p.List(srv.URL+"/mygroup/myproject", "knative-source")
+	require.NoError(t, err)
+	assert.Equal(t, []*ProjectAccessToken{{
+		ID:        1,
+		ProjectID: 7,
+		ExpiresAt: time.Date(2026, 11, 16, 0, 0, 0, 0, time.UTC),
+	}, {
+		ID:        3,
+		ProjectID: 7,
+		ExpiresAt: time.Date(2026, 12, 16, 0, 0, 0, 0, time.UTC),
+	}}, toks)
+}
+
+func TestAccessTokenProvisionerRotate(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v4/projects/7/access_tokens/9/rotate", r.URL.EscapedPath())
+		assert.Equal(t, "2026-12-16", requestBody(t, r)["expires_at"])
+
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"id":         10,
+			"token":      "glpat-rotated",
+			"expires_at": "2026-12-16",
+		})
+	})
+
+	p := NewAccessTokenProvisioner(testSecretGetter, srv.URL, testSecretKeyRef("access-token"))
+
+	tok, err := p.Rotate(7, 9, time.Date(2026, 12, 16, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectAccessToken{
+		ID:        10,
+		ProjectID: 7,
+		Token:     "glpat-rotated",
+		ExpiresAt: time.Date(2026, 12, 16, 0, 0, 0, 0, time.UTC),
+	}, tok)
+}
+
+func TestAccessTokenProvisionerRevoke(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/7/access_tokens/9":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v4/projects/7/access_tokens/10":
+			writeJSON(t, w, http.StatusNotFound, map[string]any{"message": "404 Not Found"})
+		default:
+			writeJSON(t, w, http.StatusForbidden, map[string]any{"message": "403 Forbidden"})
+		}
+	})
+
+	p := NewAccessTokenProvisioner(testSecretGetter, srv.URL, testSecretKeyRef("access-token"))
+
+	assert.NoError(t, p.Revoke(7, 9))
+	assert.NoError(t, p.Revoke(7, 10), "tokens which no longer exist are revoked")
+	assert.ErrorContains(t, p.Revoke(7, 11), "revoking access token 11 of project 7")
+}
+
+func TestAccessTokenProvisionerForeignInstance(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL.EscapedPath())
+	})
+
+	p := NewAccessTokenProvisioner(testSecretGetter, srv.URL, testSecretKeyRef("access-token"))
+
+	_, err := p.Create("https://gitlab.example.com/mygroup/myproject", "knative-source", time.Now())
+	assert.ErrorIs(t, err, ErrForeignInstance)
+
+	_, err = p.List("https://gitlab.example.com/mygroup/myproject", "knative-source")
+	assert.ErrorIs(t, err, ErrForeignInstance)
+}
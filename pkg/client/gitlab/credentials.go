@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// APIScope is the scope of GitLab API tokens which is required for managing
+// hooks.
+const APIScope = "api"
+
+// Credentials are the attributes of a GitLab API token, as reported by GitLab,
+// which determine whether the token can manage the hooks of a project or
+// group.
+type Credentials struct {
+	// Identity of the user authenticated by the token. Project and group
+	// access tokens authenticate bot users.
+	UserID   int
+	Username string
+	IsAdmin  bool
+
+	// Scopes of the token, or nil if GitLab didn't report them, e.g.
+	// because the instance predates the API which returns them.
+	Scopes []string
+	// Whether the token was revoked or expired, and its expiration date.
+	Active    bool
+	ExpiresAt *time.Time
+
+	// Access level of the user on the project or group, including
+	// inherited memberships, and the access level required to manage
+	// its hooks.
+	AccessLevel         gitlab.AccessLevelValue
+	RequiredAccessLevel gitlab.AccessLevelValue
+}
+
+// HasScope returns whether the token has the given scope. Tokens whose scopes
+// are unknown are assumed to have it.
+func (c *Credentials) HasScope(scope string) bool {
+	if c.Scopes == nil {
+		return true
+	}
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// CanManageHooks returns whether the user authenticated by the token has
+// sufficient access to the project or group to manage its hooks.
+func (c *Credentials) CanManageHooks() bool {
+	return c.IsAdmin || c.AccessLevel >= c.RequiredAccessLevel
+}
+
+// memberAccessFunc returns the access level of the user with the given ID on
+// a project or group.
+type memberAccessFunc func(userID int) (*gitlab.Response, gitlab.AccessLevelValue, error)
+
+// GetCredentials returns the attributes of the API token referenced by
+// accessTokenRef, as reported by the GitLab instance with the given base URL.
+// The access level of the token's user isn't determined, since the token isn't
+// used for a particular project or group.
+func GetCredentials(sg secret.Getter, baseURL string, accessTokenRef *corev1.SecretKeySelector) (*Credentials, error) {
+	cli, _, _, err := newClientWithSecrets(sg, baseURL, accessTokenRef, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return getCredentials(cli, gitlab.NoPermissions, nil)
+}
+
+// getCredentials returns the attributes of the API token of the given GitLab
+// client, using the given function to obtain the access level of the token's
+// user, if any.
+func getCredentials(cli *gitlab.Client, required gitlab.AccessLevelValue,
+	memberAccess memberAccessFunc) (*Credentials, error) {
+
+	u, _, err := cli.Users.CurrentUser()
+	if err != nil {
+		return nil, fmt.Errorf("getting user of access token: %w", err)
+	}
+
+	creds := &Credentials{
+		UserID:              u.ID,
+		Username:            u.Username,
+		IsAdmin:             u.IsAdmin,
+		Active:              true,
+		RequiredAccessLevel: required,
+	}
+
+	tok, resp, err := cli.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	switch {
+	case isNotFound(resp, err):
+		// the attributes of the token can't be obtained, it was
+		// nevertheless accepted by GitLab
+	case err != nil:
+		return nil, fmt.Errorf("getting attributes of access token: %w", err)
+	default:
+		creds.Scopes = tok.Scopes
+		if creds.Scopes == nil {
+			creds.Scopes = []string{}
+		}
+		creds.Active = tok.Active && !tok.Revoked
+		if tok.ExpiresAt != nil {
+			expiresAt := time.Time(*tok.ExpiresAt)
+			creds.ExpiresAt = &expiresAt
+		}
+	}
+
+	if creds.IsAdmin || memberAccess == nil {
+		return creds, nil
+	}
+
+	resp, level, err := memberAccess(u.ID)
+	switch {
+	case isNotFound(resp, err):
+		// the user isn't a member of the project or group
+	case err != nil:
+		return nil, fmt.Errorf("getting access level of user %q: %w", u.Username, err)
+	default:
+		creds.AccessLevel = level
+	}
+
+	return creds, nil
+}
+
+// isNotFound returns whether the given response and error of a GitLab API call
+// indicate that the requested resource doesn't exist.
+func isNotFound(resp *gitlab.Response, err error) bool {
+	if errors.Is(err, gitlab.ErrNotFound) {
+		return true
+	}
+	return err != nil && resp != nil && resp.StatusCode == http.StatusNotFound
+}
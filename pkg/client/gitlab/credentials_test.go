@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+func TestWebhookClientCredentials(t *testing.T) {
+	const (
+		userPath   = "/api/v4/user"
+		tokenPath  = "/api/v4/personal_access_tokens/self"
+		memberPath = "/api/v4/projects/mygroup%2Fmyproject/members/all/3"
+	)
+
+	user := map[string]any{"id": 3, "username": "bot"}
+	token := map[string]any{"scopes": []string{"api"}, "active": true, "expires_at": "2026-12-01"}
+	maintainer := map[string]any{"id": 3, "access_level": 40}
+
+	expiresAt := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		// Responses of the GitLab API, by request path. Paths which
+		// aren't listed must not be requested.
+		responses map[string]testResponse
+
+		expect     *Credentials
+		expectCode int
+	}{
+		"Maintainer": {
+			responses: map[string]testResponse{
+				userPath:   {http.StatusOK, user},
+				tokenPath:  {http.StatusOK, token},
+				memberPath: {http.StatusOK, maintainer},
+			},
+			expect: &Credentials{
+				UserID:              3,
+				Username:            "bot",
+				Scopes:              []string{"api"},
+				Active:              true,
+				ExpiresAt:           &expiresAt,
+				AccessLevel:         gitlab.MaintainerPermissions,
+				RequiredAccessLevel: gitlab.MaintainerPermissions,
+			},
+		},
+		"Attributes of the token are unknown": {
+			responses: map[string]testResponse{
+				userPath:   {http.StatusOK, user},
+				tokenPath:  {http.StatusNotFound, map[string]any{"message": "404 Not Found"}},
+				memberPath: {http.StatusOK, maintainer},
+			},
+			expect: &Credentials{
+				UserID:              3,
+				Username:            "bot",
+				Active:              true,
+				AccessLevel:         gitlab.MaintainerPermissions,
+				RequiredAccessLevel: gitlab.MaintainerPermissions,
+			},
+		},
+		"Token was revoked": {
+			responses: map[string]testResponse{
+				userPath:   {http.StatusOK, user},
+				tokenPath:  {http.StatusOK, map[string]any{"scopes": nil, "active": true, "revoked": true}},
+				memberPath: {http.StatusOK, maintainer},
+			},
+			expect: &Credentials{
+				UserID:              3,
+				Username:            "bot",
+				Scopes:              []string{},
+				AccessLevel:         gitlab.MaintainerPermissions,
+				RequiredAccessLevel: gitlab.MaintainerPermissions,
+			},
+		},
+		"Administrator": {
+			responses: map[string]testResponse{
+				userPath:  {http.StatusOK, map[string]any{"id": 3, "username": "root", "is_admin": true}},
+				tokenPath: {http.StatusOK, token},
+			},
+			expect: &Credentials{
+				UserID:              3,
+				Username:            "root",
+				IsAdmin:             true,
+				Scopes:              []string{"api"},
+				Active:              true,
+				ExpiresAt:           &expiresAt,
+				RequiredAccessLevel: gitlab.MaintainerPermissions,
+			},
+		},
+		"User isn't a member": {
+			responses: map[string]testResponse{
+				userPath:   {http.StatusOK, user},
+				tokenPath:  {http.StatusOK, token},
+				memberPath: {http.StatusNotFound, map[string]any{"message": "404 Not Found"}},
+			},
+			expect: &Credentials{
+				UserID:              3,
+				Username:            "bot",
+				Scopes:              []string{"api"},
+				Active:              true,
+				ExpiresAt:           &expiresAt,
+				RequiredAccessLevel: gitlab.MaintainerPermissions,
+			},
+		},
+		"Token is invalid": {
+			responses: map[string]testResponse{
+				userPath: {http.StatusUnauthorized, map[string]any{"message": "401 Unauthorized"}},
+			},
+			expectCode: http.StatusUnauthorized,
+		},
+		"Access level can't be read": {
+			responses: map[string]testResponse{
+				userPath:   {http.StatusOK, user},
+				tokenPath:  {http.StatusOK, token},
+				memberPath: {http.StatusForbidden, map[string]any{"message": "403 Forbidden"}},
+			},
+			expectCode: http.StatusForbidden,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				resp, ok := tc.responses[r.URL.EscapedPath()]
+				if !ok {
+					t.Errorf("Unexpected request to %s", r.URL.EscapedPath())
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				writeJSON(t, w, resp.code, resp.body)
+			})
+
+			cli := newTestWebhookClient(t, &v1beta1.WebhookStatus{ProjectURL: srv.URL + "/mygroup/myproject"})
+
+			creds, err := cli.Credentials()
+
+			if tc.expectCode != 0 {
+				var errResp *gitlab.ErrorResponse
+				require.True(t, errors.As(err, &errResp), "error is a GitLab error response")
+				assert.Equal(t, tc.expectCode, errResp.Response.StatusCode)
+				assert.ErrorContains(t, err, `verifying access token for project "mygroup/myproject"`)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, creds)
+		})
+	}
+}
+
+func TestGetCredentials(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/user":
+			writeJSON(t, w, http.StatusOK, map[string]any{"id": 3, "username": "bot"})
+		case "/api/v4/personal_access_tokens/self":
+			writeJSON(t, w, http.StatusOK, map[string]any{"scopes": []string{"read_api"}, "active": true})
+		default:
+			// the access level isn't determined without project
+			t.Errorf("Unexpected request to %s", r.URL.EscapedPath())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	creds, err := GetCredentials(testSecretGetter, srv.URL, testSecretKeyRef("access-token"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, creds.UserID)
+	assert.False(t, creds.HasScope(APIScope), "token has the api scope")
+	assert.True(t, creds.CanManageHooks(), "no access level is required")
+}
+
+// testResponse is a response of a fake GitLab API.
+type testResponse struct {
+	code int
+	body any
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// secretTokenHashPrefix is the prefix of the hashes computed by a
+// SecretTokenHasher, which distinguishes them from the unkeyed hashes
+// recorded by previous versions of the controller.
+const secretTokenHashPrefix = "hmac-sha256:"
+
+// SecretTokenHasher computes the hashes of secret tokens which are recorded in
+// the status of sources and in the annotations of receive adapters, to detect
+// changes to the tokens.
+//
+// Hashes are HMACs keyed by a secret held by the controller, so that they
+// can't be used to recover tokens by brute force.
+type SecretTokenHasher struct {
+	key []byte
+}
+
+// NewSecretTokenHasher returns a SecretTokenHasher which uses the given key.
+func NewSecretTokenHasher(key []byte) *SecretTokenHasher {
+	return &SecretTokenHasher{
+		key: key,
+	}
+}
+
+// Hash returns the hash of the given secret token.
+func (h *SecretTokenHasher) Hash(secretToken string) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(secretToken))
+	return secretTokenHashPrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// hashOrEmpty returns the hash of the given secret token, or an empty string
+// if the token is nil.
+func (h *SecretTokenHasher) hashOrEmpty(secretToken *string) string {
+	if secretToken == nil {
+		return ""
+	}
+	return h.Hash(*secretToken)
+}
+
+// IsSecretTokenHash returns whether the given hash was computed by a
+// SecretTokenHasher. Other hashes, such as those recorded by previous versions
+// of the controller, can't be compared with the hashes of known tokens.
+func IsSecretTokenHash(hash string) bool {
+	return strings.HasPrefix(hash, secretTokenHashPrefix)
+}
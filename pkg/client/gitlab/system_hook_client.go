@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"fmt"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// SystemHookClient is a client which can interact with the system hooks of a
+// GitLab instance.
+//
+// Unlike project and group hooks, system hooks can not be edited. Changes to
+// the configuration of a system hook require its replacement.
+type SystemHookClient interface {
+	Get(hookID int) (*Hook, error)
+	Add(hook *Hook) (hookID int, err error)
+	Delete(hookID int) error
+}
+
+// systemHookClient is the default implementation of SystemHookClient.
+type systemHookClient struct {
+	// GitLab API client.
+	cli *gitlab.Client
+
+	// Optional user-defined token used to validate requests to webhooks.
+	// See projectWebhookClient.
+	secretToken *string
+}
+
+// systemHookClient implements SystemHookClient.
+var _ SystemHookClient = (*systemHookClient)(nil)
+
+// Get returns a system hook from the client's GitLab instance.
+func (c *systemHookClient) Get(hookID int) (*Hook, error) {
+	hook, _, err := c.cli.SystemHooks.GetHook(hookID)
+	if err != nil {
+		return nil, fmt.Errorf("getting system hook: %w", err)
+	}
+
+	return &Hook{
+		ID:  hook.ID,
+		URL: hook.URL,
+		EventTypes: enabledEventTypes(map[string]bool{
+			v1alpha1.GitLabSystemHookMergeRequests:    hook.MergeRequestsEvents,
+			v1alpha1.GitLabSystemHookPush:             hook.PushEvents,
+			v1alpha1.GitLabSystemHookRepositoryUpdate: hook.RepositoryUpdateEvents,
+			v1alpha1.GitLabSystemHookTagPush:          hook.TagPushEvents,
+		}),
+		EnableSSLVerification: hook.EnableSSLVerification,
+	}, nil
+}
+
+// Add adds a new system hook to the client's GitLab instance.
+func (c *systemHookClient) Add(hook *Hook) (hookID int, err error) {
+	events := newHookEvents(hook.EventTypes)
+
+	hookOptions := gitlab.AddHookOptions{
+		URL:                   gitlab.Ptr(hook.URL),
+		EnableSSLVerification: gitlab.Ptr(hook.EnableSSLVerification),
+		Token:                 c.secretToken,
+
+		MergeRequestsEvents:    events.flag(v1alpha1.GitLabSystemHookMergeRequests),
+		PushEvents:             events.flag(v1alpha1.GitLabSystemHookPush),
+		RepositoryUpdateEvents: events.flag(v1alpha1.GitLabSystemHookRepositoryUpdate),
+		TagPushEvents:          events.flag(v1alpha1.GitLabSystemHookTagPush),
+	}
+
+	created, _, err := c.cli.SystemHooks.AddHook(&hookOptions)
+	if err != nil {
+		return -1, fmt.Errorf("adding system hook: %w", err)
+	}
+
+	return created.ID, nil
+}
+
+// Delete removes a system hook from the client's GitLab instance.
+func (c *systemHookClient) Delete(hookID int) error {
+	if _, err := c.cli.SystemHooks.DeleteHook(hookID); err != nil {
+		return fmt.Errorf("deleting system hook: %w", err)
+	}
+
+	return nil
+}
+
+// SystemHookClientGetter can obtain a GitLab system hook client from a
+// GitLabSystemSource API object.
+type SystemHookClientGetter interface {
+	Get(*v1alpha1.GitLabSystemSource) (SystemHookClient, error)
+}
+
+// NewSystemHookClientGetter returns a SystemHookClientGetter for the given secrets getter.
+func NewSystemHookClientGetter(sg NamespacedSecretsGetter) *SystemHookClientGetterWithSecretGetter {
+	return &SystemHookClientGetterWithSecretGetter{
+		sg: sg,
+	}
+}
+
+// SystemHookClientGetterWithSecretGetter gets a GitLab client using static
+// credentials retrieved using a Secret getter.
+type SystemHookClientGetterWithSecretGetter struct {
+	sg NamespacedSecretsGetter
+}
+
+// SystemHookClientGetterWithSecretGetter implements SystemHookClientGetter.
+var _ SystemHookClientGetter = (*SystemHookClientGetterWithSecretGetter)(nil)
+
+// Get implements SystemHookClientGetter.
+func (g *SystemHookClientGetterWithSecretGetter) Get(src *v1alpha1.GitLabSystemSource) (SystemHookClient, error) {
+	cli, secretToken, _, err := newClientWithSecrets(g.sg(src.Namespace), src.Spec.InstanceURL,
+		src.Spec.AccessToken.SecretKeyRef,
+		src.Spec.SecretToken.SecretKeyRef,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &systemHookClient{
+		cli:         cli,
+		secretToken: secretToken,
+	}, nil
+}
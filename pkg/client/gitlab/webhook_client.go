@@ -17,30 +17,219 @@ limitations under the License.
 package gitlab
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
-	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1 "k8s.io/api/core/v1"
 
-	"knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
 	"knative.dev/eventing-gitlab/pkg/secret"
-	"knative.dev/pkg/apis"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
 // WebhookClient is a client which can interact with the webhook configuration
-// of a GitLab project.
+// of a GitLab project or group.
 type WebhookClient interface {
-	Get(hookID int) (*gitlab.ProjectHook, error)
-	Add(eventTypes []string, webhookURL *apis.URL, tls bool) (hookID int, err error)
-	Edit(hookID int, eventTypes []string, webhookURL *apis.URL, tls bool) error
+	Get(hookID int) (*Hook, error)
+	List() ([]*Hook, error)
+	Add(hook *Hook) (*Hook, error)
+	Edit(hook *Hook) (*Hook, error)
 	Delete(hookID int) error
+
+	// Owner returns the GitLab project or group the client interacts with.
+	Owner() (*Owner, error)
+
+	// SecretTokenHash returns a hash of the secret token which the client
+	// applies to the hooks it adds or edits, or an empty string if the
+	// client doesn't apply any.
+	SecretTokenHash() string
+
+	// Credentials returns the attributes of the client's API token which
+	// determine whether it can manage the hooks of the project or group.
+	Credentials() (*Credentials, error)
+
+	// AccessTokenHash returns a hash of the client's API token, which
+	// identifies the token without revealing it.
+	AccessTokenHash() string
+}
+
+// Owner is a GitLab project or group which hooks belong to.
+type Owner struct {
+	ID int
+	// Full path of the project (path_with_namespace) or group (full_path).
+	FullPath string
+}
+
+// Hook is the configuration of a webhook registered with GitLab, regardless of
+// whether this webhook belongs to a project or to a group.
+type Hook struct {
+	// ID of the hook. Ignored when adding a new hook.
+	ID  int
+	URL string
+
+	// Free-form description of the hook, used as a marker to identify the
+	// hooks managed by an event source.
+	Description string
+
+	// Names of the webhooks enabled on the hook, sorted in increasing
+	// lexical order.
+	EventTypes []string
+
+	EnableSSLVerification bool
+
+	// Filter applied by GitLab to the branches of push events, and strategy
+	// used to interpret it.
+	PushEventsBranchFilter string
+	BranchFilterStrategy   string
+
+	// Attributes reported by GitLab. Ignored when adding or editing a hook.
+
+	// ID of the project or group the hook belongs to.
+	OwnerID int
+	// Time at which the hook was added.
+	CreatedAt *time.Time
+	// State of the hook, which GitLab disables temporarily or permanently
+	// after failed deliveries, and the time until which it is disabled.
+	AlertStatus   string
+	DisabledUntil *time.Time
+}
+
+// ConfigHash returns a hash of the attributes of the hook which can be
+// configured through the GitLab API.
+func (h *Hook) ConfigHash() string {
+	// the secret token is deliberately left out, since GitLab never
+	// returns it
+	b, _ := json.Marshal(struct {
+		URL                    string   `json:"url"`
+		EventTypes             []string `json:"eventTypes"`
+		EnableSSLVerification  bool     `json:"enableSSLVerification"`
+		PushEventsBranchFilter string   `json:"pushEventsBranchFilter"`
+		BranchFilterStrategy   string   `json:"branchFilterStrategy"`
+	}{
+		URL:                    h.URL,
+		EventTypes:             h.EventTypes,
+		EnableSSLVerification:  h.EnableSSLVerification,
+		PushEventsBranchFilter: h.PushEventsBranchFilter,
+		BranchFilterStrategy:   h.BranchFilterStrategy,
+	})
+
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// Diff returns the names of the configurable attributes of the hook which
+// differ from the ones of the desired hook.
+//
+// The branch filter strategy is only compared when the desired hook sets one,
+// since GitLab reports its own default otherwise.
+func (h *Hook) Diff(desired *Hook) []string {
+	var drifted []string
+
+	if h.URL != desired.URL {
+		drifted = append(drifted, "url")
+	}
+	if !sameEventTypes(h.EventTypes, desired.EventTypes) {
+		drifted = append(drifted, "eventTypes")
+	}
+	if h.EnableSSLVerification != desired.EnableSSLVerification {
+		drifted = append(drifted, "sslVerify")
+	}
+	if h.PushEventsBranchFilter != desired.PushEventsBranchFilter {
+		drifted = append(drifted, "pushEventsBranchFilter")
+	}
+	if desired.BranchFilterStrategy != "" && h.BranchFilterStrategy != desired.BranchFilterStrategy {
+		drifted = append(drifted, "branchFilterStrategy")
+	}
+
+	return drifted
+}
+
+// sameEventTypes returns whether the given lists contain the same webhook
+// names, regardless of their order.
+func sameEventTypes(a, b []string) bool {
+	ea, eb := newHookEvents(a), newHookEvents(b)
+	if len(ea) != len(eb) {
+		return false
+	}
+	for typ := range ea {
+		if _, ok := eb[typ]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// hookEvents is a set of webhook names to enable on a GitLab hook.
+type hookEvents map[string]struct{}
+
+// newHookEvents returns a hookEvents containing the given webhook names.
+func newHookEvents(eventTypes []string) hookEvents {
+	e := make(hookEvents, len(eventTypes))
+	for _, typ := range eventTypes {
+		e[typ] = struct{}{}
+	}
+	return e
+}
+
+// flag returns whether the webhook with the given name should be enabled, in
+// a form suitable for usage in the options of GitLab API calls.
+//
+// Disabled webhooks are explicitly set to false instead of being omitted,
+// because GitLab enables some webhooks by default (e.g. push events).
+func (e hookEvents) flag(eventType string) *bool {
+	_, enabled := e[eventType]
+	return &enabled
+}
+
+// strOrNil returns a pointer to the given string, or nil if the string is
+// empty, for omitting optional attributes from the options of GitLab API calls.
+func strOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// listAll calls the given function with the options of each page of a
+// paginated GitLab API, until the last page is reached.
+func listAll(list func(*gitlab.ListOptions) (*gitlab.Response, error)) error {
+	opt := &gitlab.ListOptions{PerPage: 100}
+
+	for {
+		resp, err := list(opt)
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// enabledEventTypes returns the sorted names of the webhooks whose flag is set
+// in the given map.
+func enabledEventTypes(flags map[string]bool) []string {
+	eventTypes := make([]string, 0, len(flags))
+	for typ, enabled := range flags {
+		if enabled {
+			eventTypes = append(eventTypes, typ)
+		}
+	}
+	sort.Strings(eventTypes)
+
+	return eventTypes
 }
 
-// webhookClient is the default implementation of WebhookClient.
-type webhookClient struct {
+// projectWebhookClient is the implementation of WebhookClient for GitLab projects.
+type projectWebhookClient struct {
 	// GitLab API client.
 	cli *gitlab.Client
 
@@ -55,125 +244,211 @@ type webhookClient struct {
 	// the cluster on every client creation, therefore, we can limit GET
 	// requests to the Kubernetes API by reading two values at once.
 	secretToken *string
+
+	// Hasher of the secret token.
+	hasher *SecretTokenHasher
+
+	// Hash of the API token.
+	tokenHash string
 }
 
-// webhookClient implements WebhookClient.
-var _ WebhookClient = (*webhookClient)(nil)
+// projectWebhookClient implements WebhookClient.
+var _ WebhookClient = (*projectWebhookClient)(nil)
+
+// projectHook complements gitlab.ProjectHook with attributes which aren't
+// supported by the GitLab client.
+type projectHook struct {
+	gitlab.ProjectHook
+	BranchFilterStrategy string     `json:"branch_filter_strategy"`
+	DisabledUntil        *time.Time `json:"disabled_until"`
+	EmojiEvents          bool       `json:"emoji_events"`
+	VulnerabilityEvents  bool       `json:"vulnerability_events"`
+}
 
-// Get adds a new hook to the client's GitLab project.
-func (c *webhookClient) Get(hookID int) (*gitlab.ProjectHook, error) {
-	hook, _, err := c.cli.Projects.GetProjectHook(c.projectName, hookID)
-	if err != nil {
+// projectHookOptions complements the options of the GitLab client for adding
+// and editing project hooks with attributes which it doesn't support.
+// Both API calls accept the same attributes.
+type projectHookOptions struct {
+	gitlab.AddProjectHookOptions
+	BranchFilterStrategy *string `json:"branch_filter_strategy,omitempty"`
+	EmojiEvents          *bool   `json:"emoji_events,omitempty"`
+	VulnerabilityEvents  *bool   `json:"vulnerability_events,omitempty"`
+}
+
+// Get returns a hook from the client's GitLab project.
+func (c *projectWebhookClient) Get(hookID int) (*Hook, error) {
+	hook := new(projectHook)
+	if _, err := c.do(http.MethodGet, fmt.Sprintf("hooks/%d", hookID), nil, hook); err != nil {
 		return nil, fmt.Errorf("getting webhook from project %q: %w", c.projectName, err)
 	}
 
-	return hook, nil
+	return hook.toHook(), nil
 }
 
-// Add adds a new hook to the client's GitLab project.
-func (c *webhookClient) Add(eventTypes []string, webhookURL *apis.URL, tls bool) (hookID int, err error) {
-	enabled := true
-
-	hookOptions := gitlab.AddProjectHookOptions{
-		URL:                   gitlab.Ptr(webhookURL.String()),
-		EnableSSLVerification: &tls,
-		Token:                 c.secretToken,
-	}
-
-	for _, eventType := range eventTypes {
-		switch eventType {
-		case v1alpha1.GitLabWebhookConfidentialIssues:
-			hookOptions.ConfidentialIssuesEvents = &enabled
-		case v1alpha1.GitLabWebhookConfidentialNote:
-			hookOptions.ConfidentialNoteEvents = &enabled
-		// NOTE(antoineco): not supported in this version of xanzy/go-gitlab (v0.39.0)
-		//case v1alpha1.GitLabWebhookDeployment:
-		//	hookOptions.DeploymentEvents = &enabled
-		case v1alpha1.GitLabWebhookIssues:
-			hookOptions.IssuesEvents = &enabled
-		case v1alpha1.GitLabWebhookJob:
-			hookOptions.JobEvents = &enabled
-		case v1alpha1.GitLabWebhookMergeRequests:
-			hookOptions.MergeRequestsEvents = &enabled
-		case v1alpha1.GitLabWebhookNote:
-			hookOptions.NoteEvents = &enabled
-		case v1alpha1.GitLabWebhookPipeline:
-			hookOptions.PipelineEvents = &enabled
-		case v1alpha1.GitLabWebhookPush:
-			hookOptions.PushEvents = &enabled
-		case v1alpha1.GitLabWebhookTagPush:
-			hookOptions.TagPushEvents = &enabled
-		case v1alpha1.GitLabWebhookWikiPage:
-			hookOptions.WikiPageEvents = &enabled
+// List returns all hooks from the client's GitLab project.
+func (c *projectWebhookClient) List() ([]*Hook, error) {
+	var hooks []*Hook
+
+	err := listAll(func(opt *gitlab.ListOptions) (*gitlab.Response, error) {
+		var page []*projectHook
+		resp, err := c.do(http.MethodGet, "hooks", opt, &page)
+		for _, h := range page {
+			hooks = append(hooks, h.toHook())
 		}
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing webhooks of project %q: %w", c.projectName, err)
 	}
 
-	hook, _, err := c.cli.Projects.AddProjectHook(c.projectName, &hookOptions)
-	if err != nil {
-		return -1, fmt.Errorf("adding webhook to project %q: %w", c.projectName, err)
+	return hooks, nil
+}
+
+// Add adds a new hook to the client's GitLab project.
+func (c *projectWebhookClient) Add(hook *Hook) (*Hook, error) {
+	created := new(projectHook)
+	if _, err := c.do(http.MethodPost, "hooks", c.hookOptions(hook), created); err != nil {
+		return nil, fmt.Errorf("adding webhook to project %q: %w", c.projectName, err)
 	}
 
-	return hook.ID, nil
+	return created.toHook(), nil
 }
 
 // Edit edits the configuration of a hook in the client's GitLab project.
-func (c *webhookClient) Edit(hookID int, eventTypes []string, webhookURL *apis.URL, tls bool) error {
-	enabled, disabled := true, false
-
-	hookOptions := gitlab.EditProjectHookOptions{
-		URL:                   gitlab.Ptr(webhookURL.String()),
-		EnableSSLVerification: &tls,
-		Token:                 c.secretToken,
-
-		ConfidentialIssuesEvents: &disabled,
-		ConfidentialNoteEvents:   &disabled,
-		IssuesEvents:             &disabled,
-		JobEvents:                &disabled,
-		MergeRequestsEvents:      &disabled,
-		NoteEvents:               &disabled,
-		PipelineEvents:           &disabled,
-		PushEvents:               &disabled,
-		TagPushEvents:            &disabled,
-		WikiPageEvents:           &disabled,
-	}
-
-	for _, eventType := range eventTypes {
-		switch eventType {
-		case v1alpha1.GitLabWebhookConfidentialIssues:
-			hookOptions.ConfidentialIssuesEvents = &enabled
-		case v1alpha1.GitLabWebhookConfidentialNote:
-			hookOptions.ConfidentialNoteEvents = &enabled
-		// NOTE(antoineco): not supported in this version of xanzy/go-gitlab (v0.39.0)
-		//case v1alpha1.GitLabWebhookDeployment:
-		//	hookOptions.DeploymentEvents = &enabled
-		case v1alpha1.GitLabWebhookIssues:
-			hookOptions.IssuesEvents = &enabled
-		case v1alpha1.GitLabWebhookJob:
-			hookOptions.JobEvents = &enabled
-		case v1alpha1.GitLabWebhookMergeRequests:
-			hookOptions.MergeRequestsEvents = &enabled
-		case v1alpha1.GitLabWebhookNote:
-			hookOptions.NoteEvents = &enabled
-		case v1alpha1.GitLabWebhookPipeline:
-			hookOptions.PipelineEvents = &enabled
-		case v1alpha1.GitLabWebhookPush:
-			hookOptions.PushEvents = &enabled
-		case v1alpha1.GitLabWebhookTagPush:
-			hookOptions.TagPushEvents = &enabled
-		case v1alpha1.GitLabWebhookWikiPage:
-			hookOptions.WikiPageEvents = &enabled
+func (c *projectWebhookClient) Edit(hook *Hook) (*Hook, error) {
+	edited := new(projectHook)
+	if _, err := c.do(http.MethodPut, fmt.Sprintf("hooks/%d", hook.ID), c.hookOptions(hook), edited); err != nil {
+		return nil, fmt.Errorf("editing webhook in project %q: %w", c.projectName, err)
+	}
+
+	return edited.toHook(), nil
+}
+
+// Owner returns the client's GitLab project.
+func (c *projectWebhookClient) Owner() (*Owner, error) {
+	p, _, err := c.cli.Projects.GetProject(c.projectName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %q: %w", c.projectName, err)
+	}
+
+	return &Owner{
+		ID:       p.ID,
+		FullPath: p.PathWithNamespace,
+	}, nil
+}
+
+// SecretTokenHash returns a hash of the client's secret token.
+func (c *projectWebhookClient) SecretTokenHash() string {
+	return c.hasher.hashOrEmpty(c.secretToken)
+}
+
+// AccessTokenHash returns a hash of the client's API token.
+func (c *projectWebhookClient) AccessTokenHash() string {
+	return c.tokenHash
+}
+
+// Credentials returns the attributes of the client's API token. Managing the
+// hooks of a project requires the Maintainer role.
+func (c *projectWebhookClient) Credentials() (*Credentials, error) {
+	creds, err := getCredentials(c.cli, gitlab.MaintainerPermissions, func(userID int) (*gitlab.Response, gitlab.AccessLevelValue, error) {
+		m, resp, err := c.cli.ProjectMembers.GetInheritedProjectMember(c.projectName, userID)
+		if err != nil {
+			return resp, gitlab.NoPermissions, err
 		}
+		return resp, m.AccessLevel, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("verifying access token for project %q: %w", c.projectName, err)
+	}
+
+	return creds, nil
+}
+
+// toHook returns the Hook representation of a project hook.
+func (h *projectHook) toHook() *Hook {
+	return &Hook{
+		ID:          h.ID,
+		URL:         h.URL,
+		Description: h.Description,
+		EventTypes: enabledEventTypes(map[string]bool{
+			v1beta1.GitLabWebhookConfidentialIssues:  h.ConfidentialIssuesEvents,
+			v1beta1.GitLabWebhookConfidentialNote:    h.ConfidentialNoteEvents,
+			v1beta1.GitLabWebhookDeployment:          h.DeploymentEvents,
+			v1beta1.GitLabWebhookIssues:              h.IssuesEvents,
+			v1beta1.GitLabWebhookJob:                 h.JobEvents,
+			v1beta1.GitLabWebhookMergeRequests:       h.MergeRequestsEvents,
+			v1beta1.GitLabWebhookNote:                h.NoteEvents,
+			v1beta1.GitLabWebhookPipeline:            h.PipelineEvents,
+			v1beta1.GitLabWebhookPush:                h.PushEvents,
+			v1beta1.GitLabWebhookReleases:            h.ReleasesEvents,
+			v1beta1.GitLabWebhookResourceAccessToken: h.ResourceAccessTokenEvents,
+			v1beta1.GitLabWebhookTagPush:             h.TagPushEvents,
+			v1beta1.GitLabWebhookWikiPage:            h.WikiPageEvents,
+			// never enabled by the controller, but reported so that
+			// hooks which enable them are considered out of sync
+			v1beta1.GitLabWebhookEmoji:         h.EmojiEvents,
+			v1beta1.GitLabWebhookVulnerability: h.VulnerabilityEvents,
+		}),
+		EnableSSLVerification:  h.EnableSSLVerification,
+		PushEventsBranchFilter: h.PushEventsBranchFilter,
+		BranchFilterStrategy:   h.BranchFilterStrategy,
+		OwnerID:                h.ProjectID,
+		CreatedAt:              h.CreatedAt,
+		AlertStatus:            h.AlertStatus,
+		DisabledUntil:          h.DisabledUntil,
+	}
+}
+
+// hookOptions returns the options for adding or editing the given hook.
+func (c *projectWebhookClient) hookOptions(hook *Hook) *projectHookOptions {
+	events := newHookEvents(hook.EventTypes)
+
+	return &projectHookOptions{
+		AddProjectHookOptions: gitlab.AddProjectHookOptions{
+			URL:                    gitlab.Ptr(hook.URL),
+			Description:            strOrNil(hook.Description),
+			EnableSSLVerification:  gitlab.Ptr(hook.EnableSSLVerification),
+			Token:                  c.secretToken,
+			PushEventsBranchFilter: gitlab.Ptr(hook.PushEventsBranchFilter),
+
+			ConfidentialIssuesEvents:  events.flag(v1beta1.GitLabWebhookConfidentialIssues),
+			ConfidentialNoteEvents:    events.flag(v1beta1.GitLabWebhookConfidentialNote),
+			DeploymentEvents:          events.flag(v1beta1.GitLabWebhookDeployment),
+			IssuesEvents:              events.flag(v1beta1.GitLabWebhookIssues),
+			JobEvents:                 events.flag(v1beta1.GitLabWebhookJob),
+			MergeRequestsEvents:       events.flag(v1beta1.GitLabWebhookMergeRequests),
+			NoteEvents:                events.flag(v1beta1.GitLabWebhookNote),
+			PipelineEvents:            events.flag(v1beta1.GitLabWebhookPipeline),
+			PushEvents:                events.flag(v1beta1.GitLabWebhookPush),
+			ReleasesEvents:            events.flag(v1beta1.GitLabWebhookReleases),
+			ResourceAccessTokenEvents: events.flag(v1beta1.GitLabWebhookResourceAccessToken),
+			TagPushEvents:             events.flag(v1beta1.GitLabWebhookTagPush),
+			WikiPageEvents:            events.flag(v1beta1.GitLabWebhookWikiPage),
+		},
+		BranchFilterStrategy: strOrNil(hook.BranchFilterStrategy),
+		EmojiEvents:          events.flag(v1beta1.GitLabWebhookEmoji),
+		VulnerabilityEvents:  events.flag(v1beta1.GitLabWebhookVulnerability),
 	}
+}
+
+// do sends a request to the hooks API of the client's GitLab project.
+//
+// The methods of the GitLab client's ProjectsService can't be used because
+// they don't support some attributes of project hooks, such as the branch
+// filter strategy.
+func (c *projectWebhookClient) do(method, path string, opt, v any) (*gitlab.Response, error) {
+	u := fmt.Sprintf("projects/%s/%s", gitlab.PathEscape(c.projectName), path)
 
-	if _, _, err := c.cli.Projects.EditProjectHook(c.projectName, hookID, &hookOptions); err != nil {
-		return fmt.Errorf("editing webhook in project %q: %w", c.projectName, err)
+	req, err := c.cli.NewRequest(method, u, opt, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return c.cli.Do(req, v)
 }
 
 // Delete removes the webhook matching the client's configuration from a GitLab project.
-func (c *webhookClient) Delete(hookID int) error {
+func (c *projectWebhookClient) Delete(hookID int) error {
 	if _, err := c.cli.Projects.DeleteProjectHook(c.projectName, hookID); err != nil {
 		return fmt.Errorf("deleting webhook from project %q: %w", c.projectName, err)
 	}
@@ -181,51 +456,329 @@ func (c *webhookClient) Delete(hookID int) error {
 	return nil
 }
 
+// groupWebhookClient is the implementation of WebhookClient for GitLab groups.
+type groupWebhookClient struct {
+	// GitLab API client.
+	cli *gitlab.Client
+
+	// Full path of the GitLab group.
+	groupName string
+
+	// Optional user-defined token used to validate requests to webhooks.
+	// See projectWebhookClient.
+	secretToken *string
+
+	// Hasher of the secret token.
+	hasher *SecretTokenHasher
+
+	// Hash of the API token.
+	tokenHash string
+}
+
+// groupWebhookClient implements WebhookClient.
+var _ WebhookClient = (*groupWebhookClient)(nil)
+
+// groupHook complements gitlab.GroupHook with attributes which aren't
+// supported by the GitLab client.
+type groupHook struct {
+	gitlab.GroupHook
+	DisabledUntil       *time.Time `json:"disabled_until"`
+	VulnerabilityEvents bool       `json:"vulnerability_events"`
+}
+
+// groupHookOptions complements the options of the GitLab client for adding
+// and editing group hooks with attributes which it doesn't support.
+// Both API calls accept the same attributes.
+type groupHookOptions struct {
+	gitlab.AddGroupHookOptions
+	EmojiEvents         *bool `json:"emoji_events,omitempty"`
+	VulnerabilityEvents *bool `json:"vulnerability_events,omitempty"`
+}
+
+// Get returns a hook from the client's GitLab group.
+func (c *groupWebhookClient) Get(hookID int) (*Hook, error) {
+	hook := new(groupHook)
+	if _, err := c.do(http.MethodGet, fmt.Sprintf("hooks/%d", hookID), nil, hook); err != nil {
+		return nil, fmt.Errorf("getting webhook from group %q: %w", c.groupName, err)
+	}
+
+	return hook.toHook(), nil
+}
+
+// List returns all hooks from the client's GitLab group.
+func (c *groupWebhookClient) List() ([]*Hook, error) {
+	var hooks []*Hook
+
+	err := listAll(func(opt *gitlab.ListOptions) (*gitlab.Response, error) {
+		var page []*groupHook
+		resp, err := c.do(http.MethodGet, "hooks", opt, &page)
+		for _, h := range page {
+			hooks = append(hooks, h.toHook())
+		}
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing webhooks of group %q: %w", c.groupName, err)
+	}
+
+	return hooks, nil
+}
+
+// Add adds a new hook to the client's GitLab group.
+func (c *groupWebhookClient) Add(hook *Hook) (*Hook, error) {
+	created := new(groupHook)
+	if _, err := c.do(http.MethodPost, "hooks", c.hookOptions(hook), created); err != nil {
+		return nil, fmt.Errorf("adding webhook to group %q: %w", c.groupName, err)
+	}
+
+	return created.toHook(), nil
+}
+
+// Edit edits the configuration of a hook in the client's GitLab group.
+func (c *groupWebhookClient) Edit(hook *Hook) (*Hook, error) {
+	edited := new(groupHook)
+	if _, err := c.do(http.MethodPut, fmt.Sprintf("hooks/%d", hook.ID), c.hookOptions(hook), edited); err != nil {
+		return nil, fmt.Errorf("editing webhook in group %q: %w", c.groupName, err)
+	}
+
+	return edited.toHook(), nil
+}
+
+// Owner returns the client's GitLab group.
+func (c *groupWebhookClient) Owner() (*Owner, error) {
+	g, _, err := c.cli.Groups.GetGroup(c.groupName, &gitlab.GetGroupOptions{
+		WithProjects: gitlab.Ptr(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting group %q: %w", c.groupName, err)
+	}
+
+	return &Owner{
+		ID:       g.ID,
+		FullPath: g.FullPath,
+	}, nil
+}
+
+// SecretTokenHash returns a hash of the client's secret token.
+func (c *groupWebhookClient) SecretTokenHash() string {
+	return c.hasher.hashOrEmpty(c.secretToken)
+}
+
+// AccessTokenHash returns a hash of the client's API token.
+func (c *groupWebhookClient) AccessTokenHash() string {
+	return c.tokenHash
+}
+
+// Credentials returns the attributes of the client's API token. Managing the
+// hooks of a group requires the Owner role.
+func (c *groupWebhookClient) Credentials() (*Credentials, error) {
+	creds, err := getCredentials(c.cli, gitlab.OwnerPermissions, func(userID int) (*gitlab.Response, gitlab.AccessLevelValue, error) {
+		m, resp, err := c.cli.GroupMembers.GetInheritedGroupMember(c.groupName, userID)
+		if err != nil {
+			return resp, gitlab.NoPermissions, err
+		}
+		return resp, m.AccessLevel, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("verifying access token for group %q: %w", c.groupName, err)
+	}
+
+	return creds, nil
+}
+
+// hookOptions returns the options for adding or editing the given hook.
+// Both API calls accept the same attributes.
+func (c *groupWebhookClient) hookOptions(hook *Hook) *groupHookOptions {
+	events := newHookEvents(hook.EventTypes)
+
+	return &groupHookOptions{
+		AddGroupHookOptions: gitlab.AddGroupHookOptions{
+			URL:                    gitlab.Ptr(hook.URL),
+			Description:            strOrNil(hook.Description),
+			EnableSSLVerification:  gitlab.Ptr(hook.EnableSSLVerification),
+			Token:                  c.secretToken,
+			PushEventsBranchFilter: gitlab.Ptr(hook.PushEventsBranchFilter),
+			BranchFilterStrategy:   strOrNil(hook.BranchFilterStrategy),
+
+			ConfidentialIssuesEvents:  events.flag(v1beta1.GitLabWebhookConfidentialIssues),
+			ConfidentialNoteEvents:    events.flag(v1beta1.GitLabWebhookConfidentialNote),
+			DeploymentEvents:          events.flag(v1beta1.GitLabWebhookDeployment),
+			FeatureFlagEvents:         events.flag(v1beta1.GitLabWebhookFeatureFlag),
+			IssuesEvents:              events.flag(v1beta1.GitLabWebhookIssues),
+			JobEvents:                 events.flag(v1beta1.GitLabWebhookJob),
+			MemberEvents:              events.flag(v1beta1.GitLabWebhookMember),
+			MergeRequestsEvents:       events.flag(v1beta1.GitLabWebhookMergeRequests),
+			NoteEvents:                events.flag(v1beta1.GitLabWebhookNote),
+			PipelineEvents:            events.flag(v1beta1.GitLabWebhookPipeline),
+			PushEvents:                events.flag(v1beta1.GitLabWebhookPush),
+			ReleasesEvents:            events.flag(v1beta1.GitLabWebhookReleases),
+			ResourceAccessTokenEvents: events.flag(v1beta1.GitLabWebhookResourceAccessToken),
+			SubGroupEvents:            events.flag(v1beta1.GitLabWebhookSubgroup),
+			TagPushEvents:             events.flag(v1beta1.GitLabWebhookTagPush),
+			WikiPageEvents:            events.flag(v1beta1.GitLabWebhookWikiPage),
+		},
+		EmojiEvents:         events.flag(v1beta1.GitLabWebhookEmoji),
+		VulnerabilityEvents: events.flag(v1beta1.GitLabWebhookVulnerability),
+	}
+}
+
+// do sends a request to the hooks API of the client's GitLab group.
+//
+// The methods of the GitLab client's GroupsService can't be used because
+// they don't support some attributes of group hooks, such as the time until
+// which a hook is disabled.
+func (c *groupWebhookClient) do(method, path string, opt, v any) (*gitlab.Response, error) {
+	u := fmt.Sprintf("groups/%s/%s", gitlab.PathEscape(c.groupName), path)
+
+	req, err := c.cli.NewRequest(method, u, opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cli.Do(req, v)
+}
+
+// toHook returns the Hook representation of a group hook.
+func (h *groupHook) toHook() *Hook {
+	return &Hook{
+		ID:          h.ID,
+		URL:         h.URL,
+		Description: h.Description,
+		EventTypes: enabledEventTypes(map[string]bool{
+			v1beta1.GitLabWebhookConfidentialIssues:  h.ConfidentialIssuesEvents,
+			v1beta1.GitLabWebhookConfidentialNote:    h.ConfidentialNoteEvents,
+			v1beta1.GitLabWebhookDeployment:          h.DeploymentEvents,
+			v1beta1.GitLabWebhookFeatureFlag:         h.FeatureFlagEvents,
+			v1beta1.GitLabWebhookIssues:              h.IssuesEvents,
+			v1beta1.GitLabWebhookJob:                 h.JobEvents,
+			v1beta1.GitLabWebhookMember:              h.MemberEvents,
+			v1beta1.GitLabWebhookMergeRequests:       h.MergeRequestsEvents,
+			v1beta1.GitLabWebhookNote:                h.NoteEvents,
+			v1beta1.GitLabWebhookPipeline:            h.PipelineEvents,
+			v1beta1.GitLabWebhookPush:                h.PushEvents,
+			v1beta1.GitLabWebhookReleases:            h.ReleasesEvents,
+			v1beta1.GitLabWebhookResourceAccessToken: h.ResourceAccessTokenEvents,
+			v1beta1.GitLabWebhookSubgroup:            h.SubGroupEvents,
+			v1beta1.GitLabWebhookTagPush:             h.TagPushEvents,
+			v1beta1.GitLabWebhookWikiPage:            h.WikiPageEvents,
+			// never enabled by the controller, but reported so that
+			// hooks which enable them are considered out of sync
+			v1beta1.GitLabWebhookEmoji:         h.EmojiEvents,
+			v1beta1.GitLabWebhookVulnerability: h.VulnerabilityEvents,
+		}),
+		EnableSSLVerification:  h.EnableSSLVerification,
+		PushEventsBranchFilter: h.PushEventsBranchFilter,
+		BranchFilterStrategy:   h.BranchFilterStrategy,
+		OwnerID:                h.GroupID,
+		CreatedAt:              h.CreatedAt,
+		AlertStatus:            h.AlertStatus,
+		DisabledUntil:          h.DisabledUntil,
+	}
+}
+
+// Delete removes the webhook matching the client's configuration from a GitLab group.
+func (c *groupWebhookClient) Delete(hookID int) error {
+	if _, err := c.cli.Groups.DeleteGroupHook(c.groupName, hookID); err != nil {
+		return fmt.Errorf("deleting webhook from group %q: %w", c.groupName, err)
+	}
+
+	return nil
+}
+
+// ErrAccessTokenNotProvisioned indicates that a GitLabSource which doesn't
+// reference an access token has no access token provisioned for a project.
+var ErrAccessTokenNotProvisioned = errors.New("no access token was provisioned for the project")
+
 // WebhookClientGetter can obtain a GitLab webhook client from a GitLabSource
-// API object.
+// API object and the status of one of its hooks.
 type WebhookClientGetter interface {
-	Get(*v1alpha1.GitLabSource) (WebhookClient, error)
+	Get(*v1beta1.GitLabSource, *v1beta1.WebhookStatus) (WebhookClient, error)
 }
 
-// NewWebhookClientGetter returns a WebhookClientGetter for the given secrets getter.
-func NewWebhookClientGetter(sg NamespacedSecretsGetter) *WebhookClientGetterWithSecretGetter {
+// NewWebhookClientGetter returns a WebhookClientGetter for the given secrets
+// getter, whose clients hash secret tokens with the given hasher.
+func NewWebhookClientGetter(sg NamespacedSecretsGetter, hasher *SecretTokenHasher) *WebhookClientGetterWithSecretGetter {
 	return &WebhookClientGetterWithSecretGetter{
-		sg: sg,
+		sg:     sg,
+		hasher: hasher,
 	}
 }
 
-type NamespacedSecretsGetter func(namespace string) coreclientv1.SecretInterface
+// NamespacedSecretsGetter returns a Getter for the Secrets of the given
+// namespace.
+type NamespacedSecretsGetter func(namespace string) secret.Getter
 
 // WebhookClientGetterWithSecretGetter gets a GitLab client using static
 // credentials retrieved using a Secret getter.
 type WebhookClientGetterWithSecretGetter struct {
-	sg NamespacedSecretsGetter
+	sg     NamespacedSecretsGetter
+	hasher *SecretTokenHasher
 }
 
 // WebhookClientGetterWithSecretGetter implements ClientGetter.
 var _ WebhookClientGetter = (*WebhookClientGetterWithSecretGetter)(nil)
 
 // Get implements ClientGetter.
-func (g *WebhookClientGetterWithSecretGetter) Get(src *v1alpha1.GitLabSource) (WebhookClient, error) {
-	baseURL, projectName, err := splitGitLabProjectURL(src.Spec.ProjectURL)
+// The returned client interacts with the hooks of the GitLab project or group
+// which the given hook belongs to.
+func (g *WebhookClientGetterWithSecretGetter) Get(src *v1beta1.GitLabSource,
+	hook *v1beta1.WebhookStatus) (WebhookClient, error) {
+
+	baseURL, path, err := splitGitLabURL(hook.Target())
 	if err != nil {
-		return nil, fmt.Errorf("reading components from the given project URL: %w", err)
+		return nil, fmt.Errorf("reading components from the given project or group URL: %w", err)
+	}
+
+	accessTokenRef := src.AccessTokenRef(hook.Target())
+	if accessTokenRef == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAccessTokenNotProvisioned, hook.Target())
 	}
 
-	requestedSecrets, err := secret.NewGetter(g.sg(src.Namespace)).Get(
-		src.Spec.AccessToken.SecretKeyRef,
-		src.Spec.SecretToken.SecretKeyRef,
+	cli, secretToken, apiToken, err := newClientWithSecrets(g.sg(src.Namespace), baseURL,
+		accessTokenRef,
+		src.SecretTokenRef(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving user-provided GitLab secrets: %w", err)
+		return nil, err
+	}
+
+	if hook.GroupURL != "" {
+		return &groupWebhookClient{
+			cli:         cli,
+			groupName:   path,
+			secretToken: secretToken,
+			hasher:      g.hasher,
+			tokenHash:   g.hasher.Hash(apiToken),
+		}, nil
+	}
+
+	return &projectWebhookClient{
+		cli:         cli,
+		projectName: path,
+		secretToken: secretToken,
+		hasher:      g.hasher,
+		tokenHash:   g.hasher.Hash(apiToken),
+	}, nil
+}
+
+// newClientWithSecrets returns a GitLab API client for the given base URL,
+// authenticated with the API token referenced by accessTokenRef, together with
+// the optional webhook secret token referenced by secretTokenRef, and the API
+// token itself.
+func newClientWithSecrets(sg secret.Getter, baseURL string,
+	accessTokenRef, secretTokenRef *corev1.SecretKeySelector) (*gitlab.Client, *string, string, error) {
+
+	requestedSecrets, err := sg.Get(accessTokenRef, secretTokenRef)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("retrieving user-provided GitLab secrets: %w", err)
 	}
 
 	apiToken := requestedSecrets[0]
 	secretToken := requestedSecrets[1]
 
-	cli, err := gitlab.NewClient(apiToken, gitlab.WithBaseURL(baseURL))
+	glCli, err := gitlab.NewClient(apiToken, gitlab.WithBaseURL(baseURL))
 	if err != nil {
-		return nil, fmt.Errorf("creating a GitLab client: %w", err)
+		return nil, nil, "", fmt.Errorf("creating a GitLab client: %w", err)
 	}
 
 	var secretTokenPtr *string
@@ -233,39 +786,33 @@ func (g *WebhookClientGetterWithSecretGetter) Get(src *v1alpha1.GitLabSource) (W
 		secretTokenPtr = &secretToken
 	}
 
-	webhookCli := &webhookClient{
-		cli:         cli,
-		projectName: projectName,
-		secretToken: secretTokenPtr,
-	}
-
-	return webhookCli, nil
+	return glCli, secretTokenPtr, apiToken, nil
 }
 
-// splitGitLabProjectURL returns the base URL and the project name components
-// contained in the given GitLab project URL.
+// splitGitLabURL returns the base URL and the path components contained in
+// the given GitLab project or group URL.
 // Example: given the project URL "https://gitlab.example.com/myuser/myproject",
-// the returned base URL and project name are respectively "https://gitlab.example.com"
+// the returned base URL and path are respectively "https://gitlab.example.com"
 // and "myuser/myproject".
-func splitGitLabProjectURL(projectURL string) (baseURL, projectName string, err error) {
-	u, err := url.Parse(projectURL)
+func splitGitLabURL(projectOrGroupURL string) (baseURL, path string, err error) {
+	u, err := url.Parse(projectOrGroupURL)
 	if err != nil {
-		return "", "", fmt.Errorf("parsing project URL %q: %w", projectURL, err)
+		return "", "", fmt.Errorf("parsing URL %q: %w", projectOrGroupURL, err)
 	}
 
-	projectName = u.Path[1:]
-	baseURL = strings.TrimSuffix(projectURL, projectName)
+	path = u.Path[1:]
+	baseURL = strings.TrimSuffix(projectOrGroupURL, path)
 
-	return baseURL, projectName, nil
+	return baseURL, path, nil
 }
 
 // WebhookClientGetterFunc allows the use of ordinary functions as WebhookClientGetter.
-type WebhookClientGetterFunc func(*v1alpha1.GitLabSource) (WebhookClient, error)
+type WebhookClientGetterFunc func(*v1beta1.GitLabSource, *v1beta1.WebhookStatus) (WebhookClient, error)
 
 // ClientGetterFunc implements WebhookClientGetter.
 var _ WebhookClientGetter = (WebhookClientGetterFunc)(nil)
 
 // Get implements ClientGetter.
-func (f WebhookClientGetterFunc) Get(src *v1alpha1.GitLabSource) (WebhookClient, error) {
-	return f(src)
+func (f WebhookClientGetterFunc) Get(src *v1beta1.GitLabSource, hook *v1beta1.WebhookStatus) (WebhookClient, error) {
+	return f(src, hook)
 }
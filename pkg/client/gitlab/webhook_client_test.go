@@ -0,0 +1,416 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+const (
+	testAPIToken    = "api-token"
+	testSecretToken = "secret-token"
+)
+
+var testHasher = NewSecretTokenHasher([]byte("hash-key"))
+
+func TestProjectWebhookClientAdd(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v4/projects/mygroup%2Fmyproject/hooks", r.URL.EscapedPath())
+
+		body := requestBody(t, r)
+		assert.Equal(t, "https://adapter.example.com", body["url"])
+		assert.Equal(t, "marker", body["description"])
+		assert.Equal(t, testSecretToken, body["token"])
+		assert.Equal(t, true, body["enable_ssl_verification"])
+		assert.Equal(t, "release/*", body["push_events_branch_filter"])
+		assert.Equal(t, "wildcard", body["branch_filter_strategy"])
+		assert.Equal(t, true, body["push_events"])
+		assert.Equal(t, true, body["job_events"])
+		// webhooks which aren't desired are disabled explicitly
+		assert.Equal(t, false, body["issues_events"])
+		assert.Equal(t, false, body["emoji_events"])
+		assert.Equal(t, false, body["vulnerability_events"])
+
+		writeJSON(t, w, http.StatusCreated, map[string]any{
+			"id":                        1,
+			"url":                       "https://adapter.example.com",
+			"description":               "marker",
+			"project_id":                7,
+			"push_events":               true,
+			"job_events":                true,
+			"enable_ssl_verification":   true,
+			"push_events_branch_filter": "release/*",
+			"branch_filter_strategy":    "wildcard",
+			"alert_status":              "executable",
+			"created_at":                "2026-10-17T10:00:00Z",
+		})
+	})
+
+	cli := newTestWebhookClient(t, &v1beta1.WebhookStatus{ProjectURL: srv.URL + "/mygroup/myproject"})
+
+	hook, err := cli.Add(&Hook{
+		URL:                    "https://adapter.example.com",
+		Description:            "marker",
+		EventTypes:             []string{v1beta1.GitLabWebhookJob, v1beta1.GitLabWebhookPush},
+		EnableSSLVerification:  true,
+		PushEventsBranchFilter: "release/*",
+		BranchFilterStrategy:   "wildcard",
+	})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, &Hook{
+		ID:                     1,
+		URL:                    "https://adapter.example.com",
+		Description:            "marker",
+		EventTypes:             []string{v1beta1.GitLabWebhookJob, v1beta1.GitLabWebhookPush},
+		EnableSSLVerification:  true,
+		PushEventsBranchFilter: "release/*",
+		BranchFilterStrategy:   "wildcard",
+		OwnerID:                7,
+		CreatedAt:              &createdAt,
+		AlertStatus:            "executable",
+	}, hook)
+}
+
+func TestGroupWebhookClientEdit(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v4/groups/mygroup%2Fsubgroup/hooks/1", r.URL.EscapedPath())
+
+		body := requestBody(t, r)
+		assert.Equal(t, true, body["member_events"])
+		assert.Equal(t, false, body["subgroup_events"])
+		assert.Equal(t, "regex", body["branch_filter_strategy"])
+		assert.Equal(t, false, body["emoji_events"])
+		assert.NotContains(t, body, "token", "secret token")
+
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"id":                     1,
+			"url":                    "https://adapter.example.com",
+			"group_id":               8,
+			"member_events":          true,
+			"emoji_events":           true,
+			"branch_filter_strategy": "regex",
+			"alert_status":           "temporarily_disabled",
+			"disabled_until":         "2026-10-17T12:00:00Z",
+		})
+	})
+
+	cli := newTestWebhookClient(t, &v1beta1.WebhookStatus{GroupURL: srv.URL + "/mygroup/subgroup"}, withoutSecretToken)
+
+	desired := &Hook{
+		ID:                   1,
+		URL:                  "https://adapter.example.com",
+		EventTypes:           []string{v1beta1.GitLabWebhookMember},
+		BranchFilterStrategy: "regex",
+	}
+
+	hook, err := cli.Edit(desired)
+	require.NoError(t, err)
+
+	disabledUntil := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, 8, hook.OwnerID)
+	assert.Equal(t, "regex", hook.BranchFilterStrategy)
+	assert.Equal(t, "temporarily_disabled", hook.AlertStatus)
+	assert.Equal(t, &disabledUntil, hook.DisabledUntil)
+	// webhooks which the controller never enables are reported
+	assert.Equal(t, []string{v1beta1.GitLabWebhookEmoji, v1beta1.GitLabWebhookMember}, hook.EventTypes)
+	assert.Equal(t, []string{"eventTypes"}, hook.Diff(desired))
+}
+
+func TestWebhookClientList(t *testing.T) {
+	testCases := map[string]struct {
+		status   *v1beta1.WebhookStatus
+		hooksURL string
+		ownerKey string
+	}{
+		"project": {
+			status:   &v1beta1.WebhookStatus{ProjectURL: "/mygroup/myproject"},
+			hooksURL: "/api/v4/projects/mygroup%2Fmyproject/hooks",
+			ownerKey: "project_id",
+		},
+		"group": {
+			status:   &v1beta1.WebhookStatus{GroupURL: "/mygroup"},
+			hooksURL: "/api/v4/groups/mygroup/hooks",
+			ownerKey: "group_id",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, tc.hooksURL, r.URL.EscapedPath())
+				assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+				// hooks are listed in two pages
+				page := r.URL.Query().Get("page")
+				if page == "" {
+					w.Header().Set("X-Next-Page", "2")
+					writeJSON(t, w, http.StatusOK, []map[string]any{{"id": 1, tc.ownerKey: 7}})
+					return
+				}
+				assert.Equal(t, "2", page)
+				writeJSON(t, w, http.StatusOK, []map[string]any{{"id": 2, tc.ownerKey: 7, "push_events": true}})
+			})
+
+			status := tc.status.DeepCopy()
+			status.ProjectURL = prefixNonEmpty(srv.URL, status.ProjectURL)
+			status.GroupURL = prefixNonEmpty(srv.URL, status.GroupURL)
+
+			hooks, err := newTestWebhookClient(t, status).List()
+			require.NoError(t, err)
+
+			require.Len(t, hooks, 2)
+			assert.Equal(t, 1, hooks[0].ID)
+			assert.Empty(t, hooks[0].EventTypes)
+			assert.Equal(t, 2, hooks[1].ID)
+			assert.Equal(t, 7, hooks[1].OwnerID)
+			assert.Equal(t, []string{v1beta1.GitLabWebhookPush}, hooks[1].EventTypes)
+		})
+	}
+}
+
+func TestWebhookClientErrors(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/mygroup%2Fmyproject/hooks/1":
+			writeJSON(t, w, http.StatusNotFound, map[string]any{"message": "404 Not found"})
+		default:
+			writeJSON(t, w, http.StatusForbidden, map[string]any{"message": "403 Forbidden"})
+		}
+	})
+
+	cli := newTestWebhookClient(t, &v1beta1.WebhookStatus{ProjectURL: srv.URL + "/mygroup/myproject"})
+
+	_, err := cli.Get(1)
+	assert.ErrorIs(t, err, gitlab.ErrNotFound)
+	assert.ErrorContains(t, err, `getting webhook from project "mygroup/myproject"`)
+
+	_, err = cli.Add(&Hook{URL: "https://adapter.example.com"})
+	var errResp *gitlab.ErrorResponse
+	require.True(t, errors.As(err, &errResp), "error is a GitLab error response")
+	assert.Equal(t, http.StatusForbidden, errResp.Response.StatusCode)
+	assert.ErrorContains(t, err, `adding webhook to project "mygroup/myproject"`)
+}
+
+func TestWebhookClientOwner(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/mygroup%2Fmyproject":
+			writeJSON(t, w, http.StatusOK, map[string]any{"id": 7, "path_with_namespace": "mygroup/myproject"})
+		case "/api/v4/groups/mygroup":
+			// the projects of the group aren't needed
+			assert.Equal(t, "false", r.URL.Query().Get("with_projects"))
+			writeJSON(t, w, http.StatusOK, map[string]any{"id": 8, "full_path": "mygroup"})
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	owner, err := newTestWebhookClient(t, &v1beta1.WebhookStatus{ProjectURL: srv.URL + "/mygroup/myproject"}).Owner()
+	require.NoError(t, err)
+	assert.Equal(t, &Owner{ID: 7, FullPath: "mygroup/myproject"}, owner)
+
+	owner, err = newTestWebhookClient(t, &v1beta1.WebhookStatus{GroupURL: srv.URL + "/mygroup"}).Owner()
+	require.NoError(t, err)
+	assert.Equal(t, &Owner{ID: 8, FullPath: "mygroup"}, owner)
+}
+
+func TestHookDiff(t *testing.T) {
+	desired := &Hook{
+		URL:                    "https://adapter.example.com",
+		EventTypes:             []string{v1beta1.GitLabWebhookPush},
+		PushEventsBranchFilter: "main",
+	}
+
+	testCases := map[string]struct {
+		hook   func(*Hook)
+		expect []string
+	}{
+		"in sync": {
+			hook: func(*Hook) {},
+		},
+		"strategy defaulted by GitLab": {
+			hook: func(h *Hook) {
+				h.BranchFilterStrategy = "wildcard"
+			},
+		},
+		"branch filter changed": {
+			hook: func(h *Hook) {
+				h.PushEventsBranchFilter = ""
+			},
+			expect: []string{"pushEventsBranchFilter"},
+		},
+		"webhook enabled": {
+			hook: func(h *Hook) {
+				h.EventTypes = []string{v1beta1.GitLabWebhookPush, v1beta1.GitLabWebhookTagPush}
+			},
+			expect: []string{"eventTypes"},
+		},
+		"everything changed": {
+			hook: func(h *Hook) {
+				h.URL = "https://other.example.com"
+				h.EventTypes = nil
+				h.EnableSSLVerification = true
+				h.PushEventsBranchFilter = "release/*"
+			},
+			expect: []string{"url", "eventTypes", "sslVerify", "pushEventsBranchFilter"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			hook := *desired
+			tc.hook(&hook)
+			assert.Equal(t, tc.expect, hook.Diff(desired))
+		})
+	}
+
+	withStrategy := *desired
+	withStrategy.BranchFilterStrategy = "regex"
+	assert.Equal(t, []string{"branchFilterStrategy"}, (&Hook{
+		URL:                    desired.URL,
+		EventTypes:             desired.EventTypes,
+		PushEventsBranchFilter: desired.PushEventsBranchFilter,
+		BranchFilterStrategy:   "wildcard",
+	}).Diff(&withStrategy), "desired strategy is compared")
+}
+
+// newTestServer returns a fake GitLab API which serves requests with the given
+// handler, after verifying that they are authenticated with testAPIToken.
+func newTestServer(t *testing.T, h http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testAPIToken, r.Header.Get("PRIVATE-TOKEN"), "API token")
+		h(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// testClientOption alters the source of the webhook client returned by
+// newTestWebhookClient.
+type testClientOption func(*v1beta1.GitLabSource)
+
+// withoutSecretToken removes the secret token from the source.
+func withoutSecretToken(src *v1beta1.GitLabSource) {
+	src.Spec.SecretToken = nil
+}
+
+// newTestWebhookClient returns the webhook client obtained from a
+// WebhookClientGetter for the hook with the given status.
+func newTestWebhookClient(t *testing.T, status *v1beta1.WebhookStatus, opts ...testClientOption) WebhookClient {
+	t.Helper()
+
+	src := &v1beta1.GitLabSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "source"},
+		Spec: v1beta1.GitLabSourceSpec{
+			AccessToken: &v1beta1.SecretValueFromSource{SecretKeyRef: testSecretKeyRef("access-token")},
+			SecretToken: &v1beta1.SecretValueFromSource{SecretKeyRef: testSecretKeyRef("secret-token")},
+		},
+	}
+	for _, opt := range opts {
+		opt(src)
+	}
+
+	getter := NewWebhookClientGetter(func(namespace string) secret.Getter {
+		assert.Equal(t, "team-a", namespace)
+		return testSecretGetter
+	}, testHasher)
+
+	cli, err := getter.Get(src, status)
+	require.NoError(t, err)
+
+	return cli
+}
+
+// testSecretGetter returns testAPIToken and testSecretToken for the Secrets
+// returned by testSecretKeyRef.
+var testSecretGetter = secret.GetterFunc(func(refs ...*corev1.SecretKeySelector) (secret.Secrets, error) {
+	values := map[string]string{
+		"access-token": testAPIToken,
+		"secret-token": testSecretToken,
+	}
+
+	secrets := make(secret.Secrets, len(refs))
+	for i, ref := range refs {
+		if ref != nil {
+			secrets[i] = values[ref.Name]
+		}
+	}
+	return secrets, nil
+})
+
+// testSecretKeyRef returns a reference to the key "token" of the Secret with
+// the given name.
+func testSecretKeyRef(name string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  "token",
+	}
+}
+
+// requestBody returns the JSON body of the given request.
+func requestBody(t *testing.T, r *http.Request) map[string]any {
+	t.Helper()
+
+	body := make(map[string]any)
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body
+}
+
+// writeJSON writes a response with the given status code and JSON body.
+func writeJSON(t *testing.T, w http.ResponseWriter, code int, body any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+// prefixNonEmpty prefixes the given path with the given URL, unless the path is
+// empty.
+func prefixNonEmpty(url, path string) string {
+	if path == "" {
+		return ""
+	}
+	return url + path
+}
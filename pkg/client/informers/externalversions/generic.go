@@ -24,7 +24,9 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 	v1alpha1 "knative.dev/eventing-gitlab/pkg/apis/bindings/v1alpha1"
+	v1beta1 "knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
 	sourcesv1alpha1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
 )
 
 // GenericInformer is type of SharedIndexInformer which will locate and delegate to other
@@ -57,9 +59,19 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("gitlabbindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Bindings().V1alpha1().GitLabBindings().Informer()}, nil
 
+		// Group=bindings.knative.dev, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("gitlabbindings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Bindings().V1beta1().GitLabBindings().Informer()}, nil
+
 		// Group=sources.knative.dev, Version=v1alpha1
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("gitlabsources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().GitLabSources().Informer()}, nil
+	case sourcesv1alpha1.SchemeGroupVersion.WithResource("gitlabsystemsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().GitLabSystemSources().Informer()}, nil
+
+		// Group=sources.knative.dev, Version=v1beta1
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("gitlabsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().GitLabSources().Informer()}, nil
 
 	}
 
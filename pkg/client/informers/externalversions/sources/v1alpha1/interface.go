@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// GitLabSources returns a GitLabSourceInformer.
 	GitLabSources() GitLabSourceInformer
+	// GitLabSystemSources returns a GitLabSystemSourceInformer.
+	GitLabSystemSources() GitLabSystemSourceInformer
 }
 
 type version struct {
@@ -43,3 +45,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) GitLabSources() GitLabSourceInformer {
 	return &gitLabSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// GitLabSystemSources returns a GitLabSystemSourceInformer.
+func (v *version) GitLabSystemSources() GitLabSystemSourceInformer {
+	return &gitLabSystemSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -16,7 +16,7 @@ limitations under the License.
 
 // Code generated by injection-gen. DO NOT EDIT.
 
-package gitlabsource
+package gitlabsystemsource
 
 import (
 	context "context"
@@ -44,40 +44,40 @@ import (
 )
 
 // Interface defines the strongly typed interfaces to be implemented by a
-// controller reconciling v1alpha1.GitLabSource.
+// controller reconciling v1alpha1.GitLabSystemSource.
 type Interface interface {
-	// ReconcileKind implements custom logic to reconcile v1alpha1.GitLabSource. Any changes
+	// ReconcileKind implements custom logic to reconcile v1alpha1.GitLabSystemSource. Any changes
 	// to the objects .Status or .Finalizers will be propagated to the stored
 	// object. It is recommended that implementors do not call any update calls
 	// for the Kind inside of ReconcileKind, it is the responsibility of the calling
 	// controller to propagate those properties. The resource passed to ReconcileKind
 	// will always have an empty deletion timestamp.
-	ReconcileKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event
+	ReconcileKind(ctx context.Context, o *v1alpha1.GitLabSystemSource) reconciler.Event
 }
 
 // Finalizer defines the strongly typed interfaces to be implemented by a
-// controller finalizing v1alpha1.GitLabSource.
+// controller finalizing v1alpha1.GitLabSystemSource.
 type Finalizer interface {
-	// FinalizeKind implements custom logic to finalize v1alpha1.GitLabSource. Any changes
+	// FinalizeKind implements custom logic to finalize v1alpha1.GitLabSystemSource. Any changes
 	// to the objects .Status or .Finalizers will be ignored. Returning a nil or
 	// Normal type reconciler.Event will allow the finalizer to be deleted on
 	// the resource. The resource passed to FinalizeKind will always have a set
 	// deletion timestamp.
-	FinalizeKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event
+	FinalizeKind(ctx context.Context, o *v1alpha1.GitLabSystemSource) reconciler.Event
 }
 
 // ReadOnlyInterface defines the strongly typed interfaces to be implemented by a
-// controller reconciling v1alpha1.GitLabSource if they want to process resources for which
+// controller reconciling v1alpha1.GitLabSystemSource if they want to process resources for which
 // they are not the leader.
 type ReadOnlyInterface interface {
-	// ObserveKind implements logic to observe v1alpha1.GitLabSource.
+	// ObserveKind implements logic to observe v1alpha1.GitLabSystemSource.
 	// This method should not write to the API.
-	ObserveKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event
+	ObserveKind(ctx context.Context, o *v1alpha1.GitLabSystemSource) reconciler.Event
 }
 
-type doReconcile func(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event
+type doReconcile func(ctx context.Context, o *v1alpha1.GitLabSystemSource) reconciler.Event
 
-// reconcilerImpl implements controller.Reconciler for v1alpha1.GitLabSource resources.
+// reconcilerImpl implements controller.Reconciler for v1alpha1.GitLabSystemSource resources.
 type reconcilerImpl struct {
 	// LeaderAwareFuncs is inlined to help us implement reconciler.LeaderAware.
 	reconciler.LeaderAwareFuncs
@@ -86,7 +86,7 @@ type reconcilerImpl struct {
 	Client versioned.Interface
 
 	// Listers index properties about resources.
-	Lister sourcesv1alpha1.GitLabSourceLister
+	Lister sourcesv1alpha1.GitLabSystemSourceLister
 
 	// Recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
@@ -122,7 +122,7 @@ var _ controller.Reconciler = (*reconcilerImpl)(nil)
 // Check that our generated Reconciler is always LeaderAware.
 var _ reconciler.LeaderAware = (*reconcilerImpl)(nil)
 
-func NewReconciler(ctx context.Context, logger *zap.SugaredLogger, client versioned.Interface, lister sourcesv1alpha1.GitLabSourceLister, recorder record.EventRecorder, r Interface, options ...controller.Options) controller.Reconciler {
+func NewReconciler(ctx context.Context, logger *zap.SugaredLogger, client versioned.Interface, lister sourcesv1alpha1.GitLabSystemSourceLister, recorder record.EventRecorder, r Interface, options ...controller.Options) controller.Reconciler {
 	// Check the options function input. It should be 0 or 1.
 	if len(options) > 1 {
 		logger.Fatal("Up to one options struct is supported, found: ", len(options))
@@ -214,7 +214,7 @@ func (r *reconcilerImpl) Reconcile(ctx context.Context, key string) error {
 
 	// Get the resource with this namespace/name.
 
-	getter := r.Lister.GitLabSources(s.namespace)
+	getter := r.Lister.GitLabSystemSources(s.namespace)
 
 	original, err := getter.Get(s.name)
 
@@ -329,13 +329,13 @@ func (r *reconcilerImpl) Reconcile(ctx context.Context, key string) error {
 	return nil
 }
 
-func (r *reconcilerImpl) updateStatus(ctx context.Context, logger *zap.SugaredLogger, existing *v1alpha1.GitLabSource, desired *v1alpha1.GitLabSource) error {
+func (r *reconcilerImpl) updateStatus(ctx context.Context, logger *zap.SugaredLogger, existing *v1alpha1.GitLabSystemSource, desired *v1alpha1.GitLabSystemSource) error {
 	existing = existing.DeepCopy()
 	return reconciler.RetryUpdateConflicts(func(attempts int) (err error) {
 		// The first iteration tries to use the injectionInformer's state, subsequent attempts fetch the latest state via API.
 		if attempts > 0 {
 
-			getter := r.Client.SourcesV1alpha1().GitLabSources(desired.Namespace)
+			getter := r.Client.SourcesV1alpha1().GitLabSystemSources(desired.Namespace)
 
 			existing, err = getter.Get(ctx, desired.Name, metav1.GetOptions{})
 			if err != nil {
@@ -356,7 +356,7 @@ func (r *reconcilerImpl) updateStatus(ctx context.Context, logger *zap.SugaredLo
 
 		existing.Status = desired.Status
 
-		updater := r.Client.SourcesV1alpha1().GitLabSources(existing.Namespace)
+		updater := r.Client.SourcesV1alpha1().GitLabSystemSources(existing.Namespace)
 
 		_, err = updater.UpdateStatus(ctx, existing, metav1.UpdateOptions{})
 		return err
@@ -366,7 +366,7 @@ func (r *reconcilerImpl) updateStatus(ctx context.Context, logger *zap.SugaredLo
 // updateFinalizersFiltered will update the Finalizers of the resource.
 // TODO: this method could be generic and sync all finalizers. For now it only
 // updates defaultFinalizerName or its override.
-func (r *reconcilerImpl) updateFinalizersFiltered(ctx context.Context, resource *v1alpha1.GitLabSource, desiredFinalizers sets.Set[string]) (*v1alpha1.GitLabSource, error) {
+func (r *reconcilerImpl) updateFinalizersFiltered(ctx context.Context, resource *v1alpha1.GitLabSystemSource, desiredFinalizers sets.Set[string]) (*v1alpha1.GitLabSystemSource, error) {
 	if r.useServerSideApplyForFinalizers {
 		return r.updateFinalizersFilteredServerSideApply(ctx, resource, desiredFinalizers)
 	}
@@ -374,7 +374,7 @@ func (r *reconcilerImpl) updateFinalizersFiltered(ctx context.Context, resource
 }
 
 // updateFinalizersFilteredServerSideApply uses server-side apply to manage only this controller's finalizer.
-func (r *reconcilerImpl) updateFinalizersFilteredServerSideApply(ctx context.Context, resource *v1alpha1.GitLabSource, desiredFinalizers sets.Set[string]) (*v1alpha1.GitLabSource, error) {
+func (r *reconcilerImpl) updateFinalizersFilteredServerSideApply(ctx context.Context, resource *v1alpha1.GitLabSystemSource, desiredFinalizers sets.Set[string]) (*v1alpha1.GitLabSystemSource, error) {
 	// Check if we need to do anything
 	existingFinalizers := sets.New[string](resource.Finalizers...)
 
@@ -421,7 +421,7 @@ func (r *reconcilerImpl) updateFinalizersFilteredServerSideApply(ctx context.Con
 		return resource, err
 	}
 
-	patcher := r.Client.SourcesV1alpha1().GitLabSources(resource.Namespace)
+	patcher := r.Client.SourcesV1alpha1().GitLabSystemSources(resource.Namespace)
 
 	patchOpts := metav1.PatchOptions{
 		FieldManager: r.finalizerFieldManager,
@@ -442,7 +442,7 @@ func (r *reconcilerImpl) updateFinalizersFilteredServerSideApply(ctx context.Con
 }
 
 // updateFinalizersFilteredMergePatch uses merge patch to manage finalizers (legacy behavior).
-func (r *reconcilerImpl) updateFinalizersFilteredMergePatch(ctx context.Context, resource *v1alpha1.GitLabSource, desiredFinalizers sets.Set[string]) (*v1alpha1.GitLabSource, error) {
+func (r *reconcilerImpl) updateFinalizersFilteredMergePatch(ctx context.Context, resource *v1alpha1.GitLabSystemSource, desiredFinalizers sets.Set[string]) (*v1alpha1.GitLabSystemSource, error) {
 	// Don't modify the informers copy.
 	existing := resource.DeepCopy()
 
@@ -480,7 +480,7 @@ func (r *reconcilerImpl) updateFinalizersFilteredMergePatch(ctx context.Context,
 		return resource, err
 	}
 
-	patcher := r.Client.SourcesV1alpha1().GitLabSources(resource.Namespace)
+	patcher := r.Client.SourcesV1alpha1().GitLabSystemSources(resource.Namespace)
 
 	resourceName := resource.Name
 	updated, err := patcher.Patch(ctx, resourceName, types.MergePatchType, patch, metav1.PatchOptions{})
@@ -496,7 +496,7 @@ func (r *reconcilerImpl) updateFinalizersFilteredMergePatch(ctx context.Context,
 	return updated, err
 }
 
-func (r *reconcilerImpl) setFinalizerIfFinalizer(ctx context.Context, resource *v1alpha1.GitLabSource) (*v1alpha1.GitLabSource, error) {
+func (r *reconcilerImpl) setFinalizerIfFinalizer(ctx context.Context, resource *v1alpha1.GitLabSystemSource) (*v1alpha1.GitLabSystemSource, error) {
 	if _, ok := r.reconciler.(Finalizer); !ok {
 		return resource, nil
 	}
@@ -512,7 +512,7 @@ func (r *reconcilerImpl) setFinalizerIfFinalizer(ctx context.Context, resource *
 	return r.updateFinalizersFiltered(ctx, resource, finalizers)
 }
 
-func (r *reconcilerImpl) clearFinalizer(ctx context.Context, resource *v1alpha1.GitLabSource, reconcileEvent reconciler.Event) (*v1alpha1.GitLabSource, error) {
+func (r *reconcilerImpl) clearFinalizer(ctx context.Context, resource *v1alpha1.GitLabSystemSource, reconcileEvent reconciler.Event) (*v1alpha1.GitLabSystemSource, error) {
 	if _, ok := r.reconciler.(Finalizer); !ok {
 		return resource, nil
 	}
@@ -540,7 +540,7 @@ func (r *reconcilerImpl) clearFinalizer(ctx context.Context, resource *v1alpha1.
 		// when reconciling stale object from cache while the object is actually deleted.
 		logger := logging.FromContext(ctx)
 
-		getter := r.Client.SourcesV1alpha1().GitLabSources(resource.Namespace)
+		getter := r.Client.SourcesV1alpha1().GitLabSystemSources(resource.Namespace)
 
 		_, getErr := getter.Get(ctx, resource.Name, metav1.GetOptions{})
 		if errors.IsNotFound(getErr) {
@@ -16,7 +16,7 @@ limitations under the License.
 
 // Code generated by injection-gen. DO NOT EDIT.
 
-package gitlabsource
+package gitlabsystemsource
 
 import (
 	fmt "fmt"
@@ -83,7 +83,7 @@ func (s *state) isNotLeaderNorObserver() bool {
 	return false
 }
 
-func (s *state) reconcileMethodFor(o *v1alpha1.GitLabSource) (string, doReconcile) {
+func (s *state) reconcileMethodFor(o *v1alpha1.GitLabSystemSource) (string, doReconcile) {
 	if o.GetDeletionTimestamp().IsZero() {
 		if s.isLeader {
 			return reconciler.DoReconcileKind, s.reconciler.ReconcileKind
@@ -34,7 +34,7 @@ import (
 	record "k8s.io/client-go/tools/record"
 	versionedscheme "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/scheme"
 	client "knative.dev/eventing-gitlab/pkg/client/injection/client"
-	gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1alpha1/gitlabsource"
+	gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1beta1/gitlabsource"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	controller "knative.dev/pkg/controller"
 	logging "knative.dev/pkg/logging"
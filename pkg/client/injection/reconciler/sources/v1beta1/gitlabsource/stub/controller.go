@@ -21,8 +21,8 @@ package gitlabsource
 import (
 	context "context"
 
-	gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1alpha1/gitlabsource"
-	v1alpha1gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1alpha1/gitlabsource"
+	gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1beta1/gitlabsource"
+	v1beta1gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1beta1/gitlabsource"
 	configmap "knative.dev/pkg/configmap"
 	controller "knative.dev/pkg/controller"
 	logging "knative.dev/pkg/logging"
@@ -42,7 +42,7 @@ func NewController(
 	// TODO: setup additional informers here.
 
 	r := &Reconciler{}
-	impl := v1alpha1gitlabsource.NewImpl(ctx, r)
+	impl := v1beta1gitlabsource.NewImpl(ctx, r)
 
 	logger.Info("Setting up event handlers.")
 
@@ -22,8 +22,8 @@ import (
 	context "context"
 
 	v1 "k8s.io/api/core/v1"
-	v1alpha1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
-	gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1alpha1/gitlabsource"
+	v1beta1 "knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	gitlabsource "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1beta1/gitlabsource"
 	reconciler "knative.dev/pkg/reconciler"
 )
 
@@ -56,7 +56,7 @@ var _ gitlabsource.Interface = (*Reconciler)(nil)
 //var _ gitlabsource.ReadOnlyFinalizer = (*Reconciler)(nil)
 
 // ReconcileKind implements Interface.ReconcileKind.
-func (r *Reconciler) ReconcileKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event {
+func (r *Reconciler) ReconcileKind(ctx context.Context, o *v1beta1.GitLabSource) reconciler.Event {
 	// TODO: use this if the resource implements InitializeConditions.
 	// o.Status.InitializeConditions()
 
@@ -69,19 +69,19 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, o *v1alpha1.GitLabSource
 
 // Optionally, use FinalizeKind to add finalizers. FinalizeKind will be called
 // when the resource is deleted.
-//func (r *Reconciler) FinalizeKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event {
+//func (r *Reconciler) FinalizeKind(ctx context.Context, o *v1beta1.GitLabSource) reconciler.Event {
 //	// TODO: add custom finalization logic here.
 //	return nil
 //}
 
 // Optionally, use ObserveKind to observe the resource when we are not the leader.
-// func (r *Reconciler) ObserveKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event {
+// func (r *Reconciler) ObserveKind(ctx context.Context, o *v1beta1.GitLabSource) reconciler.Event {
 // 	// TODO: add custom observation logic here.
 // 	return nil
 // }
 
 // Optionally, use ObserveFinalizeKind to observe resources being finalized when we are no the leader.
-//func (r *Reconciler) ObserveFinalizeKind(ctx context.Context, o *v1alpha1.GitLabSource) reconciler.Event {
+//func (r *Reconciler) ObserveFinalizeKind(ctx context.Context, o *v1beta1.GitLabSource) reconciler.Event {
 // 	// TODO: add custom observation logic here.
 //	return nil
 //}
@@ -25,3 +25,11 @@ type GitLabSourceListerExpansion interface{}
 // GitLabSourceNamespaceListerExpansion allows custom methods to be added to
 // GitLabSourceNamespaceLister.
 type GitLabSourceNamespaceListerExpansion interface{}
+
+// GitLabSystemSourceListerExpansion allows custom methods to be added to
+// GitLabSystemSourceLister.
+type GitLabSystemSourceListerExpansion interface{}
+
+// GitLabSystemSourceNamespaceListerExpansion allows custom methods to be added to
+// GitLabSystemSourceNamespaceLister.
+type GitLabSystemSourceNamespaceListerExpansion interface{}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package accesstoken monitors the expiry of the GitLab API tokens referenced
+// by event sources and bindings.
+package accesstoken
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/pkg/kmeta"
+)
+
+const scopeName = "knative.dev/eventing-gitlab/pkg/reconciler/accesstoken"
+
+// DefaultWarningThreshold is the default duration before the expiry of an
+// access token from which warnings are emitted.
+const DefaultWarningThreshold = 14 * 24 * time.Hour
+
+// Object is an API object which references a GitLab API token.
+type Object interface {
+	kmeta.Accessor
+	runtime.Object
+}
+
+// Monitor reports the expiry of the GitLab API tokens referenced by objects of
+// a given kind, through warning events and the
+// "kn.gitlab.access_token.expiry.remaining" metric.
+type Monitor struct {
+	kind      string
+	threshold time.Duration
+
+	mu sync.Mutex
+	// expiry time of the access token of each observed object
+	expiries map[types.NamespacedName]time.Time
+}
+
+// NewMonitor returns a Monitor for the access tokens of objects of the given
+// kind, which emits warnings from the given duration before their expiry.
+func NewMonitor(kind string, threshold time.Duration) *Monitor {
+	m := &Monitor{
+		kind:      kind,
+		threshold: threshold,
+		expiries:  make(map[types.NamespacedName]time.Time),
+	}
+
+	_, err := otel.GetMeterProvider().Meter(scopeName).Float64ObservableGauge(
+		"kn.gitlab.access_token.expiry.remaining",
+		metric.WithDescription("Time remaining until the GitLab API token referenced by an object expires."),
+		metric.WithUnit("s"),
+		metric.WithFloat64Callback(m.observe),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Observe records the expiry time of the access token of the given object,
+// which is nil for tokens which don't expire, and emits a warning event
+// through the given recorder when the token expires within the monitor's
+// threshold.
+// It returns the duration after which the object should be observed again,
+// for warning about the token's imminent expiry and for reporting its expiry
+// in time, or 0 if the token doesn't expire or already expired.
+func (m *Monitor) Observe(obj Object, recorder record.EventRecorder, expiresAt *time.Time) time.Duration {
+	if expiresAt == nil {
+		m.Forget(obj)
+		return 0
+	}
+
+	m.mu.Lock()
+	m.expiries[namespacedName(obj)] = *expiresAt
+	m.mu.Unlock()
+
+	remaining := time.Until(*expiresAt)
+
+	switch {
+	case remaining <= 0:
+		return 0
+
+	case remaining <= m.threshold:
+		if recorder != nil {
+			recorder.Eventf(obj, corev1.EventTypeWarning, "AccessTokenExpiring",
+				"GitLab API token expires on %s", expiresAt.UTC().Format(time.RFC3339))
+		}
+		return remaining
+	}
+
+	return remaining - m.threshold
+}
+
+// Forget stops reporting the expiry of the access token of the given object,
+// e.g. after it was deleted.
+func (m *Monitor) Forget(obj kmeta.Accessor) {
+	m.mu.Lock()
+	delete(m.expiries, namespacedName(obj))
+	m.mu.Unlock()
+}
+
+// observe reports the time remaining until the expiry of the access token of
+// each observed object.
+func (m *Monitor) observe(_ context.Context, o metric.Float64Observer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for nn, expiresAt := range m.expiries {
+		o.Observe(time.Until(expiresAt).Seconds(), metric.WithAttributes(
+			attribute.String("k8s.namespace.name", nn.Namespace),
+			attribute.String("kn.gitlab.resource.kind", m.kind),
+			attribute.String("kn.gitlab.resource.name", nn.Name),
+		))
+	}
+
+	return nil
+}
+
+// namespacedName returns the namespaced name of the given object.
+func namespacedName(obj kmeta.Accessor) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
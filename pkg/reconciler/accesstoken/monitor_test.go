@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesstoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+const testThreshold = 14 * 24 * time.Hour
+
+func TestMonitorObserve(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Expiry of the access token relative to the time of the
+		// observation, if the token expires.
+		expiresIn *time.Duration
+
+		expectWarning bool
+		// Expected duration after which the object should be observed
+		// again, give or take a minute.
+		expectRequeue time.Duration
+		expectTracked bool
+	}{
+		{
+			name: "Token doesn't expire",
+		},
+		{
+			name:          "Token expired",
+			expiresIn:     ptr(-time.Hour),
+			expectTracked: true,
+		},
+		{
+			name:          "Token expires within the threshold",
+			expiresIn:     ptr(testThreshold - time.Hour),
+			expectWarning: true,
+			expectRequeue: testThreshold - time.Hour,
+			expectTracked: true,
+		},
+		{
+			name:          "Token expires after the threshold",
+			expiresIn:     ptr(testThreshold + time.Hour),
+			expectRequeue: time.Hour,
+			expectTracked: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMonitor("GitLabSource", testThreshold)
+			obj := newTestObject()
+
+			// the object was observed with a previous token
+			m.Observe(obj, nil, ptr(time.Now().Add(testThreshold*2)))
+
+			var expiresAt *time.Time
+			if tc.expiresIn != nil {
+				expiresAt = ptr(time.Now().Add(*tc.expiresIn))
+			}
+
+			recorder := record.NewFakeRecorder(10)
+
+			requeue := m.Observe(obj, recorder, expiresAt)
+			assert.InDelta(t, tc.expectRequeue, requeue, float64(time.Minute))
+
+			tracked, ok := m.expiries[namespacedName(obj)]
+			assert.Equal(t, tc.expectTracked, ok, "expiry is tracked")
+			if tc.expectTracked {
+				assert.Equal(t, *expiresAt, tracked)
+			}
+
+			if !tc.expectWarning {
+				assert.Empty(t, recorder.Events, "events")
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, "Warning AccessTokenExpiring GitLab API token expires on "+
+				expiresAt.UTC().Format(time.RFC3339), <-recorder.Events)
+		})
+	}
+}
+
+func TestMonitorObserveWithoutRecorder(t *testing.T) {
+	m := NewMonitor("GitLabBinding", testThreshold)
+
+	requeue := m.Observe(newTestObject(), nil, ptr(time.Now().Add(time.Hour)))
+	assert.InDelta(t, time.Hour, requeue, float64(time.Minute))
+}
+
+func TestMonitorForget(t *testing.T) {
+	m := NewMonitor("GitLabSource", testThreshold)
+	obj := newTestObject()
+
+	m.Observe(obj, nil, ptr(time.Now().Add(time.Hour)))
+	require.Len(t, m.expiries, 1)
+
+	m.Forget(obj)
+	assert.Empty(t, m.expiries)
+}
+
+func TestMonitorMetric(t *testing.T) {
+	m := NewMonitor("GitLabSource", testThreshold)
+	obj := newTestObject()
+
+	m.Observe(obj, nil, ptr(time.Now().Add(time.Hour)))
+
+	o := &testObserver{}
+	require.NoError(t, m.observe(context.Background(), o))
+
+	require.Len(t, o.values, 1)
+	assert.InDelta(t, time.Hour.Seconds(), o.values[0], time.Minute.Seconds())
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", "team-a"),
+		attribute.String("kn.gitlab.resource.kind", "GitLabSource"),
+		attribute.String("kn.gitlab.resource.name", "source"),
+	}, o.attrs[0].ToSlice())
+}
+
+// testObserver is a metric.Float64Observer which records observed values.
+type testObserver struct {
+	embedded.Float64Observer
+
+	values []float64
+	attrs  []attribute.Set
+}
+
+var _ metric.Float64Observer = (*testObserver)(nil)
+
+func (o *testObserver) Observe(v float64, opts ...metric.ObserveOption) {
+	o.values = append(o.values, v)
+	o.attrs = append(o.attrs, metric.NewObserveConfig(opts).Attributes())
+}
+
+// newTestObject returns an object which references an access token.
+func newTestObject() *v1beta1.GitLabSource {
+	return &v1beta1.GitLabSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-a",
+			Name:      "source",
+		},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -18,9 +18,14 @@ package binding
 
 import (
 	"context"
+	"time"
 
-	glbinformer "knative.dev/eventing-gitlab/pkg/client/injection/informers/bindings/v1alpha1/gitlabbinding"
+	"github.com/kelseyhightower/envconfig"
+
+	glbinformer "knative.dev/eventing-gitlab/pkg/client/injection/informers/bindings/v1beta1/gitlabbinding"
+	"knative.dev/eventing-gitlab/pkg/reconciler/accesstoken"
 	"knative.dev/pkg/client/injection/ducks/duck/v1/podspecable"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/reconciler"
 
@@ -28,9 +33,10 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"knative.dev/eventing-gitlab/pkg/apis/bindings/v1alpha1"
+	"knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
 	"knative.dev/pkg/apis/duck"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -43,6 +49,12 @@ const (
 	controllerAgentName = "gitlabbinding-controller"
 )
 
+type envConfig struct {
+	// Duration before the expiry of the access token of a binding from
+	// which warnings are emitted.
+	TokenExpiryWarningThreshold time.Duration `envconfig:"GL_TOKEN_EXPIRY_WARNING_THRESHOLD" default:"336h"`
+}
+
 // NewController returns a new GitLabBinding reconciler.
 func NewController(
 	ctx context.Context,
@@ -50,11 +62,30 @@ func NewController(
 ) *controller.Impl {
 	logger := logging.FromContext(ctx)
 
+	env := &envConfig{}
+	envconfig.MustProcess("", env)
+
 	glbInformer := glbinformer.Get(ctx)
 	dc := dynamicclient.Get(ctx)
 	psInformerFactory := podspecable.Get(ctx)
 	namespaceInformer := namespace.Get(ctx)
 
+	// the warnings about expiring access tokens must reach the API
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(
+		&typedcorev1.EventSinkImpl{Interface: kubeclient.Get(ctx).CoreV1().Events("")})
+	go func() {
+		<-ctx.Done()
+		eventBroadcaster.Shutdown()
+	}()
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: controllerAgentName})
+
+	cr := &credentialsReconciler{
+		kubeClientSet: kubeclient.Get(ctx),
+		recorder:      recorder,
+		tokenExpiry:   accesstoken.NewMonitor("GitLabBinding", env.TokenExpiryWarningThreshold),
+	}
+
 	c := &psbinding.BaseReconciler{
 		LeaderAwareFuncs: reconciler.LeaderAwareFuncs{
 			PromoteFunc: func(bkt reconciler.Bucket, enq func(reconciler.Bucket, types.NamespacedName)) error {
@@ -71,20 +102,22 @@ func NewController(
 				return nil
 			},
 		},
-		GVR: v1alpha1.SchemeGroupVersion.WithResource("gitlabbindings"),
+		GVR: v1beta1.SchemeGroupVersion.WithResource("gitlabbindings"),
 		Get: func(namespace string, name string) (psbinding.Bindable, error) {
 			return glbInformer.Lister().GitLabBindings(namespace).Get(name)
 		},
-		DynamicClient: dc,
-		Recorder: record.NewBroadcaster().NewRecorder(
-			scheme.Scheme, corev1.EventSource{Component: controllerAgentName}),
-		NamespaceLister: namespaceInformer.Lister(),
+		DynamicClient:          dc,
+		Recorder:               recorder,
+		NamespaceLister:        namespaceInformer.Lister(),
+		SubResourcesReconciler: cr,
 	}
 	impl := controller.NewContext(ctx, c, controller.ControllerOptions{
 		Logger:        logger,
 		WorkQueueName: "GitLabBindings",
 	})
 
+	cr.enqueueAfter = impl.EnqueueAfter
+
 	glbInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 	namespaceInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/pkg/webhook/psbinding"
+
+	gogitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+	"knative.dev/eventing-gitlab/pkg/reconciler/accesstoken"
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// credentialsReconciler monitors the expiry of the access tokens of
+// GitLabBindings whose GitLab instance is known.
+type credentialsReconciler struct {
+	kubeClientSet kubernetes.Interface
+	recorder      record.EventRecorder
+	tokenExpiry   *accesstoken.Monitor
+
+	// Enqueues a binding after the given duration.
+	enqueueAfter func(obj interface{}, after time.Duration)
+}
+
+// credentialsReconciler implements psbinding.SubResourcesReconcilerInterface.
+var _ psbinding.SubResourcesReconcilerInterface = (*credentialsReconciler)(nil)
+
+// Reconcile implements psbinding.SubResourcesReconcilerInterface.
+func (r *credentialsReconciler) Reconcile(ctx context.Context, fb psbinding.Bindable) error {
+	b := fb.(*v1beta1.GitLabBinding)
+
+	if b.Spec.InstanceURL == "" {
+		b.Status.AccessTokenExpiresAt = nil
+		b.Status.ClearCredentialsCondition()
+		r.tokenExpiry.Forget(b)
+		return nil
+	}
+
+	sg := secret.NewGetter(r.kubeClientSet.CoreV1().Secrets(b.Namespace))
+
+	creds, err := gitlab.GetCredentials(sg, b.Spec.InstanceURL, b.Spec.AccessToken.SecretKeyRef)
+	switch {
+	case missingSecretReason(err) != "":
+		b.Status.MarkCredentialsInvalid(missingSecretReason(err), "Error obtaining the GitLab access token: %s", err)
+		return nil
+
+	case isUnauthorized(err):
+		// GitLab doesn't report anything about expired tokens, so we
+		// rely on the expiry time observed previously
+		if exp := b.Status.AccessTokenExpiresAt; exp != nil && !exp.After(time.Now()) {
+			b.Status.MarkCredentialsInvalid("TokenExpired",
+				"The GitLab access token expired on %s", exp.UTC().Format(time.RFC3339))
+		} else {
+			b.Status.MarkCredentialsInvalid("TokenRevoked", "The GitLab access token is invalid, expired or revoked")
+		}
+		return nil
+
+	case err != nil:
+		b.Status.MarkCredentialsUnknown("VerificationFailed", "Error verifying the GitLab access token: %s", err)
+		return fmt.Errorf("verifying GitLab access token: %w", err)
+	}
+
+	b.Status.AccessTokenExpiresAt = nil
+	if creds.ExpiresAt != nil {
+		b.Status.AccessTokenExpiresAt = &metav1.Time{Time: *creds.ExpiresAt}
+	}
+
+	switch {
+	case !creds.Active && creds.ExpiresAt != nil && !creds.ExpiresAt.After(time.Now()):
+		b.Status.MarkCredentialsInvalid("TokenExpired",
+			"The GitLab access token expired on %s", creds.ExpiresAt.UTC().Format(time.RFC3339))
+	case !creds.Active:
+		b.Status.MarkCredentialsInvalid("TokenRevoked", "The GitLab access token is revoked")
+	default:
+		b.Status.MarkCredentialsValid()
+	}
+
+	if after := r.tokenExpiry.Observe(b, r.recorder, creds.ExpiresAt); after > 0 {
+		r.enqueueAfter(b, after)
+	}
+
+	return nil
+}
+
+// ReconcileDeletion implements psbinding.SubResourcesReconcilerInterface.
+func (r *credentialsReconciler) ReconcileDeletion(ctx context.Context, fb psbinding.Bindable) error {
+	r.tokenExpiry.Forget(fb)
+	return nil
+}
+
+// missingSecretReason returns the reason of the condition which reports the
+// given error obtaining the access token, or an empty string if the error
+// doesn't indicate that the token's Secret, key or value is missing.
+func missingSecretReason(err error) string {
+	switch {
+	case errors.Is(err, secret.ErrSecretNotFound):
+		return "SecretNotFound"
+	case errors.Is(err, secret.ErrKeyNotFound):
+		return "SecretKeyNotFound"
+	case errors.Is(err, secret.ErrEmptyValue):
+		return "EmptySecretValue"
+	default:
+		return ""
+	}
+}
+
+// isUnauthorized returns whether the given error indicates that GitLab
+// rejected an API token.
+func isUnauthorized(err error) bool {
+	if glErr := (*gogitlab.ErrorResponse)(nil); errors.As(err, &glErr) {
+		return glErr.Response.StatusCode == http.StatusUnauthorized
+	}
+	return false
+}
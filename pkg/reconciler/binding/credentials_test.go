@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/eventing-gitlab/pkg/apis/bindings/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/reconciler/accesstoken"
+)
+
+const testThreshold = 14 * 24 * time.Hour
+
+func TestReconcileCredentials(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name string
+		// Whether the binding references its GitLab instance.
+		noInstance bool
+		// Whether the Secret of the access token exists.
+		noSecret bool
+		// Status and body of the responses of the GitLab API about the
+		// user and the token.
+		userCode  int
+		token     map[string]any
+		tokenCode int
+		// Expiry of the token observed previously.
+		knownExpiry *time.Time
+
+		expectStatus  corev1.ConditionStatus
+		expectReason  string
+		expectErr     bool
+		expectExpiry  bool
+		expectWarning bool
+		expectRequeue bool
+	}{
+		{
+			name:       "Instance is unknown",
+			noInstance: true,
+		},
+		{
+			name:         "Secret doesn't exist",
+			noSecret:     true,
+			expectStatus: corev1.ConditionFalse,
+			expectReason: "SecretNotFound",
+		},
+		{
+			name:          "Token is active",
+			userCode:      http.StatusOK,
+			token:         map[string]any{"active": true, "expires_at": expiryDate(testThreshold + 48*time.Hour)},
+			tokenCode:     http.StatusOK,
+			expectStatus:  corev1.ConditionTrue,
+			expectExpiry:  true,
+			expectRequeue: true,
+		},
+		{
+			name:          "Token expires within the threshold",
+			userCode:      http.StatusOK,
+			token:         map[string]any{"active": true, "expires_at": expiryDate(48 * time.Hour)},
+			tokenCode:     http.StatusOK,
+			expectStatus:  corev1.ConditionTrue,
+			expectExpiry:  true,
+			expectWarning: true,
+			expectRequeue: true,
+		},
+		{
+			name:         "Token doesn't expire",
+			userCode:     http.StatusOK,
+			token:        map[string]any{"active": true},
+			tokenCode:    http.StatusOK,
+			expectStatus: corev1.ConditionTrue,
+		},
+		{
+			name:         "Attributes of the token are unknown",
+			userCode:     http.StatusOK,
+			tokenCode:    http.StatusNotFound,
+			expectStatus: corev1.ConditionTrue,
+		},
+		{
+			name:         "Token expired",
+			userCode:     http.StatusOK,
+			token:        map[string]any{"active": false, "expires_at": past.Format(time.DateOnly)},
+			tokenCode:    http.StatusOK,
+			expectStatus: corev1.ConditionFalse,
+			expectReason: "TokenExpired",
+			expectExpiry: true,
+		},
+		{
+			name:         "Token was revoked",
+			userCode:     http.StatusOK,
+			token:        map[string]any{"active": false, "revoked": true},
+			tokenCode:    http.StatusOK,
+			expectStatus: corev1.ConditionFalse,
+			expectReason: "TokenRevoked",
+		},
+		{
+			name:         "Token is rejected",
+			userCode:     http.StatusUnauthorized,
+			expectStatus: corev1.ConditionFalse,
+			expectReason: "TokenRevoked",
+		},
+		{
+			name:         "Token is rejected after its known expiry",
+			userCode:     http.StatusUnauthorized,
+			knownExpiry:  &past,
+			expectStatus: corev1.ConditionFalse,
+			expectReason: "TokenExpired",
+			expectExpiry: true,
+		},
+		{
+			name:         "Verification is denied",
+			userCode:     http.StatusForbidden,
+			expectStatus: corev1.ConditionUnknown,
+			expectReason: "VerificationFailed",
+			expectErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "api-token", r.Header.Get("PRIVATE-TOKEN"))
+
+				switch r.URL.Path {
+				case "/api/v4/user":
+					writeJSON(t, w, tc.userCode, map[string]any{"id": 3, "username": "bot"})
+				case "/api/v4/personal_access_tokens/self":
+					writeJSON(t, w, tc.tokenCode, tc.token)
+				default:
+					t.Errorf("Unexpected request to %s", r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			b := newTestGitLabBinding(srv.URL)
+			if tc.noInstance {
+				b.Spec.InstanceURL = ""
+			}
+			if tc.knownExpiry != nil {
+				b.Status.AccessTokenExpiresAt = &metav1.Time{Time: *tc.knownExpiry}
+			}
+
+			kc := fake.NewSimpleClientset()
+			if !tc.noSecret {
+				kc = fake.NewSimpleClientset(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: b.Namespace, Name: "gitlab-token"},
+					Data:       map[string][]byte{"token": []byte("api-token")},
+				})
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			var requeued []time.Duration
+
+			r := &credentialsReconciler{
+				kubeClientSet: kc,
+				recorder:      recorder,
+				tokenExpiry:   accesstoken.NewMonitor("GitLabBinding", testThreshold),
+				enqueueAfter: func(_ interface{}, after time.Duration) {
+					requeued = append(requeued, after)
+				},
+			}
+
+			err := r.Reconcile(context.Background(), b)
+			assert.Equal(t, tc.expectErr, err != nil, "error returned: %v", err)
+
+			cond := b.Status.GetCondition(v1beta1.GitLabBindingConditionCredentialsValid)
+			if tc.expectStatus == "" {
+				assert.Nil(t, cond, "condition")
+			} else {
+				require.NotNil(t, cond, "condition")
+				assert.Equal(t, tc.expectStatus, cond.Status)
+				assert.Equal(t, tc.expectReason, cond.Reason)
+			}
+
+			assert.Equal(t, tc.expectExpiry, b.Status.AccessTokenExpiresAt != nil, "expiry recorded")
+			assert.Equal(t, tc.expectWarning, len(recorder.Events) > 0, "warning emitted")
+			assert.Equal(t, tc.expectRequeue, len(requeued) > 0, "requeued")
+		})
+	}
+}
+
+// newTestGitLabBinding returns a binding whose access token is issued by the
+// GitLab instance with the given URL.
+func newTestGitLabBinding(instanceURL string) *v1beta1.GitLabBinding {
+	return &v1beta1.GitLabBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-a",
+			Name:      "binding",
+		},
+		Spec: v1beta1.GitLabBindingSpec{
+			AccessToken: v1beta1.SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gitlab-token"},
+					Key:                  "token",
+				},
+			},
+			InstanceURL: instanceURL,
+		},
+	}
+}
+
+// expiryDate returns the date at which a token expires when it expires after
+// the given duration.
+func expiryDate(after time.Duration) string {
+	return time.Now().Add(after).UTC().Format(time.DateOnly)
+}
+
+// writeJSON writes a response of the GitLab API with the given status and
+// body.
+func writeJSON(t *testing.T, w http.ResponseWriter, code int, body any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if body == nil {
+		body = map[string]any{"message": http.StatusText(code)}
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Errorf("Failed to write response: %v", err)
+	}
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// accessTokenReconciler reconciles the project access tokens provisioned for
+// GitLab event sources which don't reference an access token: one token per
+// project, stored in a Secret owned by the source and rotated before it
+// expires.
+type accessTokenReconciler struct {
+	// Secrets of the sources, shared with the secretTokenReconciler.
+	secrets *secretTracker
+
+	// Provisioner of project access tokens, nil if provisioning is
+	// disabled.
+	provisioner gitlab.AccessTokenProvisioner
+	// Projects which access tokens may be provisioned for, per namespace.
+	allowList *provisioningAllowList
+
+	// Lifetime of provisioned access tokens, which are rotated once half
+	// of their lifetime elapsed.
+	lifetime time.Duration
+}
+
+// errProvisioningNotAllowed indicates that access tokens may not be provisioned
+// for a project of a source, because the project isn't in the allow-list of
+// the source's namespace.
+var errProvisioningNotAllowed = errors.New("provisioning of access tokens is not allowed for the project in this namespace")
+
+// reconcileAccessTokens ensures that an access token is provisioned for each
+// project of sources which don't reference an access token in their spec, and
+// that these tokens are rotated before they expire.
+// It returns the duration after which the next token must be rotated.
+func (r *accessTokenReconciler) reconcileAccessTokens(ctx context.Context, src *v1beta1.GitLabSource) (time.Duration, error) {
+	if src.DeclaredAccessTokenRef() != nil {
+		// tokens provisioned before the spec referenced an access token
+		// are no longer used
+		if err := r.revokeAccessTokens(ctx, src, nil); err != nil {
+			return 0, err
+		}
+		// the Secret is left to the garbage collector
+		src.Status.AccessTokenSecretName = ""
+		return 0, nil
+	}
+
+	if r.provisioner == nil {
+		return 0, errors.New("provisioning of access tokens is disabled in the controller, " +
+			"the spec must reference an access token")
+	}
+	if r.secrets.readsFiles() {
+		return 0, errors.New("access tokens are only provisioned when secrets are read from the Kubernetes API, " +
+			"the spec must reference an access token")
+	}
+
+	// tokens of projects which were removed from the allow-list are
+	// revoked, since they grant access to these projects
+	allowed := make(map[string]struct{})
+	for _, tok := range src.Status.ProvisionedAccessTokens {
+		if r.allowList.allows(src.Namespace, tok.ProjectURL) {
+			allowed[tok.ProjectURL] = struct{}{}
+		}
+	}
+	if err := r.revokeAccessTokens(ctx, src, allowed); err != nil {
+		return 0, err
+	}
+
+	for _, target := range src.WebhookTargets() {
+		if !r.allowList.allows(src.Namespace, target) {
+			return 0, fmt.Errorf("%s: %w, it must be listed for namespace %q in the ConfigMap %s",
+				target, errProvisioningNotAllowed, src.Namespace, tokenProvisioningConfigMapName)
+		}
+	}
+
+	name := accessTokenSecretName(src)
+
+	secr, err := r.secrets.getSecret(ctx, src.Namespace, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		secr, err = r.secrets.secretCli(src.Namespace).Create(ctx, newAccessTokenSecret(src, name), metav1.CreateOptions{})
+		if err != nil {
+			return 0, fmt.Errorf("creating Secret for provisioned access tokens: %w", err)
+		}
+
+	case err != nil:
+		return 0, fmt.Errorf("getting Secret for provisioned access tokens: %w", err)
+
+	case !metav1.IsControlledBy(secr, src):
+		return 0, fmt.Errorf("secret %q already exists and is not owned by the source", name)
+	}
+
+	src.Status.AccessTokenSecretName = name
+
+	// the value of each token which is created or rotated is stored in
+	// the Secret, under a key derived from the token's ID, before the
+	// token is recorded in the status. Tokens whose status couldn't be
+	// updated are therefore adopted from GitLab, and tokens whose value
+	// couldn't be stored are replaced instead of being leaked.
+	secr = secr.DeepCopy()
+	if secr.Data == nil {
+		secr.Data = make(map[string][]byte)
+	}
+
+	var requeueAfter time.Duration
+	var errs []error
+
+	for _, target := range src.WebhookTargets() {
+		tok := findProvisionedAccessToken(src, target)
+
+		if tok == nil {
+			adopted, err := r.adoptAccessToken(ctx, src, secr, target)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", target, err))
+				continue
+			}
+			if adopted != nil {
+				tok = setProvisionedAccessToken(src, target, adopted)
+			}
+		}
+
+		var provisioned *gitlab.ProjectAccessToken
+		var err error
+
+		switch {
+		case tok == nil:
+			provisioned, err = r.createAccessToken(src, target)
+
+		case len(secr.Data[tok.SecretKey()]) == 0 || !tok.ExpiresAt.After(time.Now()):
+			// the token is unusable, either because its value is
+			// unknown or because it expired
+			provisioned, err = r.replaceAccessToken(src, tok)
+
+		case time.Until(tok.ExpiresAt.Time) < r.lifetime/2:
+			provisioned, err = r.provisioner.Rotate(tok.ProjectID, tok.TokenID, time.Now().Add(r.lifetime))
+			if hasStatusCode(err, http.StatusBadRequest) || hasStatusCode(err, http.StatusNotFound) {
+				// tokens which were revoked in GitLab can't be
+				// rotated
+				provisioned, err = r.replaceAccessToken(src, tok)
+			}
+
+		default:
+			requeueAfter = minRequeue(requeueAfter, time.Until(tok.ExpiresAt.Time)-r.lifetime/2)
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target, err))
+			continue
+		}
+
+		reason, verb := "AccessTokenProvisioned", "Provisioned"
+		if tok != nil {
+			reason, verb = "AccessTokenRotated", "Rotated"
+		}
+
+		tok = setProvisionedAccessToken(src, target, provisioned)
+		secr.Data[tok.SecretKey()] = []byte(provisioned.Token)
+
+		updated, err := r.secrets.secretCli(src.Namespace).Update(ctx, secr, metav1.UpdateOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: storing access token %d: %w", target, tok.TokenID, err))
+		} else {
+			secr = updated
+		}
+
+		requeueAfter = minRequeue(requeueAfter, time.Until(tok.ExpiresAt.Time)-r.lifetime/2)
+
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, reason,
+			"%s access token %d of %s, which expires on %s", verb, tok.TokenID, target,
+			tok.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	// values of tokens which are no longer provisioned are removed
+	secretChanged := false
+	for key := range secr.Data {
+		if !isProvisionedAccessTokenKey(src, key) {
+			delete(secr.Data, key)
+			secretChanged = true
+		}
+	}
+
+	if secretChanged {
+		if _, err := r.secrets.secretCli(src.Namespace).Update(ctx, secr, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("updating Secret for provisioned access tokens: %w", err))
+		}
+	}
+
+	return requeueAfter, errors.Join(errs...)
+}
+
+// createAccessToken creates an access token for the given project of the
+// given source.
+func (r *accessTokenReconciler) createAccessToken(src *v1beta1.GitLabSource,
+	projectURL string) (*gitlab.ProjectAccessToken, error) {
+
+	return r.provisioner.Create(projectURL, accessTokenName(src), time.Now().Add(r.lifetime))
+}
+
+// adoptAccessToken returns the access token of the given project which GitLab
+// lists for the given source and whose value is stored in the given Secret,
+// and revokes the other tokens it lists for the source. Such tokens exist when
+// the status of the source couldn't be updated after they were created.
+// Returns nil if no token can be adopted.
+func (r *accessTokenReconciler) adoptAccessToken(ctx context.Context, src *v1beta1.GitLabSource,
+	secr *corev1.Secret, projectURL string) (*gitlab.ProjectAccessToken, error) {
+
+	toks, err := r.provisioner.List(projectURL, accessTokenName(src))
+	if err != nil {
+		return nil, err
+	}
+
+	var adopted *gitlab.ProjectAccessToken
+
+	for _, tok := range toks {
+		key := (&v1beta1.ProvisionedAccessToken{TokenID: tok.ID}).SecretKey()
+		if adopted == nil && len(secr.Data[key]) > 0 && tok.ExpiresAt.After(time.Now()) {
+			adopted = tok
+			controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "AccessTokenAdopted",
+				"Adopted access token %d of %s", tok.ID, projectURL)
+			continue
+		}
+
+		if err := r.provisioner.Revoke(tok.ProjectID, tok.ID); err != nil {
+			return nil, err
+		}
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "AccessTokenRevoked",
+			"Revoked unrecorded access token %d of %s", tok.ID, projectURL)
+	}
+
+	return adopted, nil
+}
+
+// accessTokenName returns the name of the access tokens provisioned for the
+// given source. It includes the UID of the source, so that the tokens of
+// sources with the same name in other clusters are never adopted or revoked.
+func accessTokenName(src *v1beta1.GitLabSource) string {
+	return fmt.Sprintf("knative-gitlabsource-%s-%s-%s", src.Namespace, src.Name, src.UID)
+}
+
+// replaceAccessToken revokes the given access token of the given source, and
+// creates a new one for the same project.
+func (r *accessTokenReconciler) replaceAccessToken(src *v1beta1.GitLabSource,
+	tok *v1beta1.ProvisionedAccessToken) (*gitlab.ProjectAccessToken, error) {
+
+	if err := r.provisioner.Revoke(tok.ProjectID, tok.TokenID); err != nil {
+		return nil, err
+	}
+	return r.createAccessToken(src, tok.ProjectURL)
+}
+
+// revokeAccessTokens revokes the access tokens provisioned for the projects of
+// the given source which aren't in the given set, and removes them from the
+// source's status. A nil set revokes all tokens.
+//
+// When provisioning was disabled in the controller, tokens can't be revoked
+// anymore, so they are only forgotten and warning events are recorded.
+func (r *accessTokenReconciler) revokeAccessTokens(ctx context.Context, src *v1beta1.GitLabSource,
+	keep map[string]struct{}) error {
+
+	var remaining []v1beta1.ProvisionedAccessToken
+	var errs []error
+
+	for _, tok := range src.Status.ProvisionedAccessTokens {
+		if _, isKept := keep[tok.ProjectURL]; isKept {
+			remaining = append(remaining, tok)
+			continue
+		}
+
+		if r.provisioner == nil {
+			controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeWarning, "FailedAccessTokenRevoke",
+				"Provisioning of access tokens is disabled, access token %d of %s must be revoked manually",
+				tok.TokenID, tok.ProjectURL)
+			continue
+		}
+
+		if err := r.provisioner.Revoke(tok.ProjectID, tok.TokenID); err != nil {
+			remaining = append(remaining, tok)
+			errs = append(errs, fmt.Errorf("%s: %w", tok.ProjectURL, err))
+			continue
+		}
+
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "AccessTokenRevoked",
+			"Revoked access token %d of %s", tok.TokenID, tok.ProjectURL)
+	}
+
+	src.Status.ProvisionedAccessTokens = remaining
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("revoking provisioned access tokens: %w", err)
+	}
+	return nil
+}
+
+// revokeUnusedAccessTokens revokes the access tokens provisioned for projects
+// which neither belong to the given source's spec, nor have a hook left over
+// from a previous spec.
+func (r *accessTokenReconciler) revokeUnusedAccessTokens(ctx context.Context, src *v1beta1.GitLabSource) error {
+	if src.DeclaredAccessTokenRef() != nil {
+		return nil
+	}
+
+	used := make(map[string]struct{})
+	for _, target := range src.WebhookTargets() {
+		used[target] = struct{}{}
+	}
+	for _, hook := range src.Status.Webhooks {
+		used[hook.Target()] = struct{}{}
+	}
+
+	return r.revokeAccessTokens(ctx, src, used)
+}
+
+// findProvisionedAccessToken returns the access token provisioned for the
+// given project of the given source, or nil if there is none.
+func findProvisionedAccessToken(src *v1beta1.GitLabSource, projectURL string) *v1beta1.ProvisionedAccessToken {
+	for i := range src.Status.ProvisionedAccessTokens {
+		if tok := &src.Status.ProvisionedAccessTokens[i]; tok.ProjectURL == projectURL {
+			return tok
+		}
+	}
+	return nil
+}
+
+// setProvisionedAccessToken records the given access token of the given
+// project in the status of the given source, replacing the previous token of
+// that project.
+func setProvisionedAccessToken(src *v1beta1.GitLabSource, projectURL string,
+	provisioned *gitlab.ProjectAccessToken) *v1beta1.ProvisionedAccessToken {
+
+	tok := findProvisionedAccessToken(src, projectURL)
+	if tok == nil {
+		src.Status.ProvisionedAccessTokens = append(src.Status.ProvisionedAccessTokens,
+			v1beta1.ProvisionedAccessToken{ProjectURL: projectURL})
+		tok = &src.Status.ProvisionedAccessTokens[len(src.Status.ProvisionedAccessTokens)-1]
+	}
+
+	tok.ProjectID = provisioned.ProjectID
+	tok.TokenID = provisioned.ID
+	tok.ExpiresAt = metav1.NewTime(provisioned.ExpiresAt)
+
+	return tok
+}
+
+// isProvisionedAccessTokenKey returns whether the given key of the Secret of
+// the given source contains the value of a provisioned access token.
+func isProvisionedAccessTokenKey(src *v1beta1.GitLabSource, key string) bool {
+	for i := range src.Status.ProvisionedAccessTokens {
+		if src.Status.ProvisionedAccessTokens[i].SecretKey() == key {
+			return true
+		}
+	}
+	return false
+}
+
+// accessTokenSecretName returns the name of the Secret which contains the
+// values of the access tokens provisioned for the given source.
+func accessTokenSecretName(src *v1beta1.GitLabSource) string {
+	return kmeta.ChildName(src.Name, "-access-token")
+}
+
+// newAccessTokenSecret returns an empty Secret owned by the given source, for
+// storing the values of its provisioned access tokens.
+func newAccessTokenSecret(src *v1beta1.GitLabSource, name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: src.Namespace,
+			Labels: map[string]string{
+				"receive-adapter":    "gitlab",
+				secret.WatchLabelKey: "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(src),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/configmap"
+)
+
+// tokenProvisioningConfigMapName is the name of the ConfigMap, in the
+// controller's namespace, which lists the GitLab groups and projects that
+// access tokens may be provisioned for, per namespace of sources.
+const tokenProvisioningConfigMapName = "config-gitlab-token-provisioning"
+
+// provisioningAllowList is the set of GitLab groups and projects which access
+// tokens may be provisioned for, per namespace of sources.
+//
+// The provisioner's API token can manage the access tokens of every project
+// it has access to, so provisioning must be restricted explicitly: otherwise
+// any user who can create a source would be granted access to any of these
+// projects. Nothing is allowed until the ConfigMap lists it.
+type provisioningAllowList struct {
+	// URL of the GitLab instance which access tokens are provisioned on.
+	baseURL string
+
+	mu sync.RWMutex
+	// Lower-cased paths of groups and projects, by namespace.
+	paths map[string][]string
+}
+
+// newProvisioningAllowList returns an empty provisioningAllowList for the
+// GitLab instance with the given URL.
+func newProvisioningAllowList(baseURL string) *provisioningAllowList {
+	return &provisioningAllowList{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// update replaces the content of the allow-list with the one of the given
+// ConfigMap. Each key of the ConfigMap is a namespace, and its value a list
+// of paths of groups and projects separated by whitespace or commas. Keys
+// starting with "_" are ignored, e.g. "_example".
+func (l *provisioningAllowList) update(cm *corev1.ConfigMap) {
+	paths := make(map[string][]string, len(cm.Data))
+	for ns, val := range cm.Data {
+		if strings.HasPrefix(ns, "_") {
+			continue
+		}
+		for _, p := range strings.FieldsFunc(val, isPathSeparator) {
+			if p = strings.Trim(p, "/"); p != "" {
+				paths[ns] = append(paths[ns], strings.ToLower(p))
+			}
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.paths = paths
+}
+
+// allows returns whether an access token may be provisioned for the project
+// with the given URL, for a source of the given namespace. The project must be
+// listed for that namespace, or belong to a group which is.
+func (l *provisioningAllowList) allows(namespace, projectURL string) bool {
+	path, found := strings.CutPrefix(projectURL, l.baseURL+"/")
+	if !found {
+		return false
+	}
+	path = strings.ToLower(strings.Trim(path, "/"))
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, allowed := range l.paths[namespace] {
+		if path == allowed || strings.HasPrefix(path, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// watch keeps the allow-list in sync with its ConfigMap, and calls onChange
+// whenever it changes. The allow-list is empty while the ConfigMap doesn't
+// exist.
+func (l *provisioningAllowList) watch(cmw configmap.Watcher, onChange func()) {
+	observer := func(cm *corev1.ConfigMap) {
+		l.update(cm)
+		onChange()
+	}
+
+	if dcmw, ok := cmw.(configmap.DefaultingWatcher); ok {
+		dcmw.WatchWithDefault(corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: tokenProvisioningConfigMapName},
+			Data:       map[string]string{},
+		}, observer)
+	} else {
+		cmw.Watch(tokenProvisioningConfigMapName, observer)
+	}
+}
+
+// isPathSeparator returns whether the given rune separates the paths listed
+// in the allow-list.
+func isPathSeparator(r rune) bool {
+	return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
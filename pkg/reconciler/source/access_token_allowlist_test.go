@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestProvisioningAllowList(t *testing.T) {
+	l := newProvisioningAllowList("https://gitlab.example.com/")
+
+	assert.False(t, l.allows("team-a", "https://gitlab.example.com/team-a/project"),
+		"nothing is allowed before the ConfigMap is read")
+
+	l.update(&corev1.ConfigMap{Data: map[string]string{
+		"_example": "team-b",
+		"team-a":   "team-a\n shared/Tools/, other/project",
+	}})
+
+	testCases := []struct {
+		name      string
+		namespace string
+		url       string
+		expect    bool
+	}{
+		{
+			name:      "Project of allowed group",
+			namespace: "team-a",
+			url:       "https://gitlab.example.com/team-a/project",
+			expect:    true,
+		},
+		{
+			name:      "Project of allowed subgroup",
+			namespace: "team-a",
+			url:       "https://gitlab.example.com/shared/tools/sub/project",
+			expect:    true,
+		},
+		{
+			name:      "Allowed project",
+			namespace: "team-a",
+			url:       "https://gitlab.example.com/other/project",
+			expect:    true,
+		},
+		{
+			name:      "Paths are case insensitive",
+			namespace: "team-a",
+			url:       "https://gitlab.example.com/Team-A/Project",
+			expect:    true,
+		},
+		{
+			name:      "Group with the same prefix",
+			namespace: "team-a",
+			url:       "https://gitlab.example.com/team-ab/project",
+			expect:    false,
+		},
+		{
+			name:      "Other project of the group of an allowed project",
+			namespace: "team-a",
+			url:       "https://gitlab.example.com/other/project2",
+			expect:    false,
+		},
+		{
+			name:      "Other namespace",
+			namespace: "team-c",
+			url:       "https://gitlab.example.com/team-a/project",
+			expect:    false,
+		},
+		{
+			name:      "Ignored key",
+			namespace: "_example",
+			url:       "https://gitlab.example.com/team-b/project",
+			expect:    false,
+		},
+		{
+			name:      "Other instance",
+			namespace: "team-a",
+			url:       "https://gitlab.example.org/team-a/project",
+			expect:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, l.allows(tc.namespace, tc.url))
+		})
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/pkg/controller"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+)
+
+const (
+	testNamespace  = "team-a"
+	testProjectURL = "https://gitlab.example.com/team-a/project"
+	testProjectID  = 42
+)
+
+func TestReconcileAccessTokens(t *testing.T) {
+	src := newTestGitLabSource()
+	tokenName := accessTokenName(src)
+	expiresAt := time.Now().Add(20 * 24 * time.Hour)
+
+	testCases := []struct {
+		name string
+		// Namespace the project is allowed for.
+		allowedNamespace string
+		// Tokens recorded in the status of the source.
+		status []v1beta1.ProvisionedAccessToken
+		// Values stored in the Secret of the source.
+		secretData map[string]string
+		// Active tokens of the project in GitLab.
+		tokens           []*gitlab.ProjectAccessToken
+		failSecretUpdate bool
+
+		expectErr      error
+		expectTokenIDs []int
+		expectCreated  int
+		expectRevoked  []int
+		expectKeys     []string
+	}{
+		{
+			name:             "Token is created",
+			allowedNamespace: testNamespace,
+			expectTokenIDs:   []int{1},
+			expectCreated:    1,
+			expectKeys:       []string{"token-1"},
+		},
+		{
+			name:             "Recorded token is kept",
+			allowedNamespace: testNamespace,
+			status:           []v1beta1.ProvisionedAccessToken{newProvisionedAccessToken(5, expiresAt)},
+			secretData:       map[string]string{"token-5": "value"},
+			tokens:           []*gitlab.ProjectAccessToken{newProjectAccessToken(5, expiresAt)},
+			expectTokenIDs:   []int{5},
+			expectKeys:       []string{"token-5"},
+		},
+		{
+			// the token was created and stored, but the status of
+			// the source couldn't be updated
+			name:             "Unrecorded token with stored value is adopted",
+			allowedNamespace: testNamespace,
+			secretData:       map[string]string{"token-5": "value"},
+			tokens:           []*gitlab.ProjectAccessToken{newProjectAccessToken(5, expiresAt)},
+			expectTokenIDs:   []int{5},
+			expectKeys:       []string{"token-5"},
+		},
+		{
+			// the token was created, but neither its value nor the
+			// status of the source could be updated
+			name:             "Unrecorded token without stored value is revoked",
+			allowedNamespace: testNamespace,
+			tokens:           []*gitlab.ProjectAccessToken{newProjectAccessToken(5, expiresAt)},
+			expectTokenIDs:   []int{6},
+			expectCreated:    1,
+			expectRevoked:    []int{5},
+			expectKeys:       []string{"token-6"},
+		},
+		{
+			name:             "Token is recorded when its value can't be stored",
+			allowedNamespace: testNamespace,
+			failSecretUpdate: true,
+			expectErr:        errFakeUpdate,
+			expectTokenIDs:   []int{1},
+			expectCreated:    1,
+		},
+		{
+			name:             "Project isn't allowed in the namespace",
+			allowedNamespace: "team-b",
+			expectErr:        errProvisioningNotAllowed,
+		},
+		{
+			name:             "Token of project removed from the allow-list is revoked",
+			allowedNamespace: "team-b",
+			status:           []v1beta1.ProvisionedAccessToken{newProvisionedAccessToken(5, expiresAt)},
+			secretData:       map[string]string{"token-5": "value"},
+			tokens:           []*gitlab.ProjectAccessToken{newProjectAccessToken(5, expiresAt)},
+			expectErr:        errProvisioningNotAllowed,
+			expectRevoked:    []int{5},
+			expectKeys:       []string{"token-5"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := src.DeepCopy()
+			src.Status.ProvisionedAccessTokens = tc.status
+
+			kc := fake.NewSimpleClientset(newTestAccessTokenSecret(src, tc.secretData))
+			if tc.failSecretUpdate {
+				kc.PrependReactor("update", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errFakeUpdate
+				})
+			}
+
+			prov := newFakeProvisioner(tokenName, tc.tokens...)
+			r := newTestAccessTokenReconciler(kc, prov, tc.allowedNamespace)
+
+			ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+			_, err := r.reconcileAccessTokens(ctx, src)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var tokenIDs []int
+			for _, tok := range src.Status.ProvisionedAccessTokens {
+				tokenIDs = append(tokenIDs, tok.TokenID)
+			}
+			assert.Equal(t, tc.expectTokenIDs, tokenIDs, "recorded tokens")
+			assert.Equal(t, tc.expectCreated, prov.created, "created tokens")
+			assert.Equal(t, tc.expectRevoked, prov.revoked, "revoked tokens")
+
+			secr, err := kc.CoreV1().Secrets(testNamespace).Get(ctx, accessTokenSecretName(src), metav1.GetOptions{})
+			require.NoError(t, err)
+			var keys []string
+			for key := range secr.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			assert.Equal(t, tc.expectKeys, keys, "stored tokens")
+		})
+	}
+}
+
+// TestReconcileAccessTokensStatusUpdateFailure ensures that a token is
+// neither leaked nor created twice when the status of a source can't be
+// updated after the token was created.
+func TestReconcileAccessTokensStatusUpdateFailure(t *testing.T) {
+	src := newTestGitLabSource()
+
+	kc := fake.NewSimpleClientset()
+	prov := newFakeProvisioner(accessTokenName(src))
+	r := newTestAccessTokenReconciler(kc, prov, testNamespace)
+
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	_, err := r.reconcileAccessTokens(ctx, src.DeepCopy())
+	require.NoError(t, err)
+	require.Equal(t, 1, prov.created)
+
+	// the status of the source wasn't updated
+	_, err = r.reconcileAccessTokens(ctx, src)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, prov.created, "created tokens")
+	assert.Empty(t, prov.revoked, "revoked tokens")
+	require.Len(t, src.Status.ProvisionedAccessTokens, 1)
+	assert.Equal(t, 1, src.Status.ProvisionedAccessTokens[0].TokenID)
+}
+
+var errFakeUpdate = errors.New("fake update failure")
+
+// fakeProvisioner is an in-memory gitlab.AccessTokenProvisioner for a single
+// project.
+type fakeProvisioner struct {
+	// Active tokens, by ID.
+	tokens map[int]*gitlab.ProjectAccessToken
+	// Name of the tokens listed by List.
+	name string
+
+	lastID  int
+	created int
+	revoked []int
+}
+
+var _ gitlab.AccessTokenProvisioner = (*fakeProvisioner)(nil)
+
+func newFakeProvisioner(name string, toks ...*gitlab.ProjectAccessToken) *fakeProvisioner {
+	p := &fakeProvisioner{
+		tokens: make(map[int]*gitlab.ProjectAccessToken),
+		name:   name,
+	}
+	for _, tok := range toks {
+		p.tokens[tok.ID] = tok
+		p.lastID = max(p.lastID, tok.ID)
+	}
+	return p
+}
+
+func (p *fakeProvisioner) Create(_, _ string, expiresAt time.Time) (*gitlab.ProjectAccessToken, error) {
+	p.lastID++
+	p.created++
+
+	tok := newProjectAccessToken(p.lastID, expiresAt)
+	p.tokens[tok.ID] = tok
+
+	created := *tok
+	created.Token = "value"
+	return &created, nil
+}
+
+func (p *fakeProvisioner) Rotate(projectID, tokenID int, expiresAt time.Time) (*gitlab.ProjectAccessToken, error) {
+	if err := p.Revoke(projectID, tokenID); err != nil {
+		return nil, err
+	}
+	return p.Create("", "", expiresAt)
+}
+
+func (p *fakeProvisioner) Revoke(_, tokenID int) error {
+	if _, exists := p.tokens[tokenID]; exists {
+		delete(p.tokens, tokenID)
+		p.revoked = append(p.revoked, tokenID)
+	}
+	return nil
+}
+
+func (p *fakeProvisioner) List(_, name string) ([]*gitlab.ProjectAccessToken, error) {
+	if name != p.name {
+		return nil, nil
+	}
+
+	toks := make([]*gitlab.ProjectAccessToken, 0, len(p.tokens))
+	for _, tok := range p.tokens {
+		toks = append(toks, tok)
+	}
+	sort.Slice(toks, func(i, j int) bool { return toks[i].ID < toks[j].ID })
+	return toks, nil
+}
+
+func newTestAccessTokenReconciler(kc *fake.Clientset, prov gitlab.AccessTokenProvisioner,
+	allowedNamespace string) *accessTokenReconciler {
+
+	allowList := newProvisioningAllowList("https://gitlab.example.com")
+	allowList.update(&corev1.ConfigMap{Data: map[string]string{allowedNamespace: "team-a"}})
+
+	return &accessTokenReconciler{
+		secrets: &secretTracker{
+			secretCli:    kc.CoreV1().Secrets,
+			secretLister: corelistersv1.NewSecretLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		},
+		provisioner: prov,
+		allowList:   allowList,
+		lifetime:    30 * 24 * time.Hour,
+	}
+}
+
+func newTestGitLabSource() *v1beta1.GitLabSource {
+	return &v1beta1.GitLabSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "source",
+			UID:       "00000000-0000-0000-0000-000000000001",
+		},
+		Spec: v1beta1.GitLabSourceSpec{
+			ProjectURL: testProjectURL,
+			EventTypes: []string{v1beta1.GitLabWebhookPush},
+		},
+	}
+}
+
+// newTestAccessTokenSecret returns the Secret of the provisioned access tokens
+// of the given source, with the given values.
+func newTestAccessTokenSecret(src *v1beta1.GitLabSource, data map[string]string) *corev1.Secret {
+	secr := newAccessTokenSecret(src, accessTokenSecretName(src))
+	for key, val := range data {
+		if secr.Data == nil {
+			secr.Data = make(map[string][]byte)
+		}
+		secr.Data[key] = []byte(val)
+	}
+	return secr
+}
+
+func newProvisionedAccessToken(id int, expiresAt time.Time) v1beta1.ProvisionedAccessToken {
+	return v1beta1.ProvisionedAccessToken{
+		ProjectURL: testProjectURL,
+		ProjectID:  testProjectID,
+		TokenID:    id,
+		ExpiresAt:  metav1.NewTime(expiresAt),
+	}
+}
+
+func newProjectAccessToken(id int, expiresAt time.Time) *gitlab.ProjectAccessToken {
+	return &gitlab.ProjectAccessToken{
+		ID:        id,
+		ProjectID: testProjectID,
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,808 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	appsclientv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	appslistersv1 "k8s.io/client-go/listers/apps/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/resolver"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingclientv1 "knative.dev/serving/pkg/client/clientset/versioned/typed/serving/v1"
+	servinglisters "knative.dev/serving/pkg/client/listers/serving/v1"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+// adapterLabels are the labels of all receive adapters.
+var adapterLabels = map[string]string{
+	"receive-adapter": "gitlab",
+}
+
+// adapterReconciler reconciles the receive adapters of GitLab event sources.
+type adapterReconciler struct {
+	// Clients for Knative Services. Nil when Knative Serving isn't
+	// installed in the cluster.
+	ksvcCli    func(namespace string) servingclientv1.ServiceInterface
+	ksvcLister servinglisters.ServiceLister
+
+	deploymentCli    func(namespace string) appsclientv1.DeploymentInterface
+	deploymentLister appslistersv1.DeploymentLister
+	serviceCli       func(namespace string) coreclientv1.ServiceInterface
+
+	receiveAdapterImage string
+
+	// Mode of receive adapters whose source doesn't specify one.
+	defaultMode v1beta1.AdapterMode
+
+	// Maximum number of receive adapters which can be rolling out a new
+	// image at once. Zero means unlimited.
+	maxConcurrentUpgrades int
+	// Receive adapters which are being upgraded to a new image.
+	upgrades *upgradeSet
+
+	configs source.ConfigAccessor
+
+	exposureReconciler
+}
+
+// adapterOwner is an object which owns a receive adapter.
+type adapterOwner interface {
+	kmeta.OwnerRefable
+	runtime.Object
+}
+
+// adapterStatus describes the state of a running receive adapter.
+type adapterStatus struct {
+	// Whether the adapter is ready to receive events, and the URL it
+	// receives events at.
+	ready bool
+	url   *apis.URL
+
+	// Name of the Service which exposes the adapter inside the source's
+	// namespace. Empty for the shared adapter.
+	service string
+
+	// Reason why the running adapter doesn't match its desired state, or an
+	// empty string if it does.
+	outOfDate string
+
+	// Whether the upgrade of the adapter to a new image was postponed
+	// because too many adapters are being upgraded at once.
+	upgradeDeferred bool
+}
+
+// upgradeRetryPeriod is the period after which the upgrade of a receive
+// adapter is retried after it was postponed.
+const upgradeRetryPeriod = 30 * time.Second
+
+// adapterArgs are the source-specific attributes of a receive adapter.
+type adapterArgs struct {
+	// Source which owns the receive adapter.
+	owner adapterOwner
+
+	// Mode of the receive adapter. Defaults to the controller's mode.
+	mode v1beta1.AdapterMode
+
+	// Exposure of the receive adapter outside of the cluster. Defaults to
+	// the controller's exposure.
+	exposure *v1beta1.AdapterExposure
+	// URL registered with GitLab in place of the adapter's URL, if any.
+	webhookURL *apis.URL
+
+	// Customizations of the Pods of the receive adapter.
+	template *v1beta1.AdapterTemplate
+
+	serviceAccountName string
+	secretToken        *corev1.SecretKeySelector
+	sinkURI            *apis.URL
+
+	// Secret token which remains valid while the secret token is being
+	// rotated, and hash of the current secret token, if known.
+	previousSecretToken *corev1.SecretKeySelector
+	secretTokenHash     string
+
+	// Source attribute of emitted CloudEvents.
+	eventSource string
+	// Whether the source attribute of emitted CloudEvents should be read
+	// from the payload of GitLab events.
+	eventSourceFromPayload bool
+}
+
+// resolveSinkURL resolves the URL of a sink reference.
+func resolveSinkURL(ctx context.Context, r *resolver.URIResolver, src kmeta.Accessor,
+	sink *duckv1.Destination) (*apis.URL, error) {
+
+	if sinkRef := &sink.Ref; *sinkRef != nil && (*sinkRef).Namespace == "" {
+		(*sinkRef).Namespace = src.GetNamespace()
+	}
+
+	return r.URIFromDestinationV1(ctx, *sink, src)
+}
+
+// adapterMode returns the mode in which the receive adapter of a source which
+// requests the given mode is run.
+func (r *adapterReconciler) adapterMode(mode v1beta1.AdapterMode) v1beta1.AdapterMode {
+	if mode == "" {
+		return r.defaultMode
+	}
+	return mode
+}
+
+// reconcileAdapter reconciles the state of the source's adapter, in the mode
+// requested for the source, and removes the adapter previously deployed in
+// another mode, if any.
+func (r *adapterReconciler) reconcileAdapter(ctx context.Context, args *adapterArgs) (*adapterStatus, error) {
+	mode := r.adapterMode(args.mode)
+
+	var adapter *adapterStatus
+	var err error
+
+	switch mode {
+	case v1beta1.AdapterModeDeployment:
+		if adapter, err = r.reconcileDeploymentAdapter(ctx, args); err != nil {
+			return nil, err
+		}
+		if err := r.deleteKnativeServiceAdapter(ctx, args.owner); err != nil {
+			return nil, fmt.Errorf("deleting receive adapter Knative Service: %w", err)
+		}
+
+	case v1beta1.AdapterModeShared:
+		if adapter, err = r.reconcileSharedAdapter(args); err != nil {
+			return nil, err
+		}
+		if err := r.deleteKnativeServiceAdapter(ctx, args.owner); err != nil {
+			return nil, fmt.Errorf("deleting receive adapter Knative Service: %w", err)
+		}
+		if err := r.deleteDeploymentAdapter(ctx, args.owner); err != nil {
+			return nil, fmt.Errorf("deleting receive adapter Deployment: %w", err)
+		}
+
+	default:
+		if r.ksvcCli == nil {
+			return nil, fmt.Errorf("knative Serving is not installed, the %q adapter mode is required",
+				v1beta1.AdapterModeDeployment)
+		}
+		if adapter, err = r.reconcileKnativeServiceAdapter(ctx, args); err != nil {
+			return nil, err
+		}
+		if err := r.deleteDeploymentAdapter(ctx, args.owner); err != nil {
+			return nil, fmt.Errorf("deleting receive adapter Deployment: %w", err)
+		}
+	}
+
+	return r.exposeAdapter(ctx, args, adapter)
+}
+
+// reconcileKnativeServiceAdapter reconciles a receive adapter running as a
+// Knative Service.
+// Existing adapters are updated whenever the attributes managed by the
+// controller differ from their desired state.
+func (r *adapterReconciler) reconcileKnativeServiceAdapter(ctx context.Context, args *adapterArgs) (*adapterStatus, error) {
+	desired := r.generateKnativeServiceObject(args, r.receiveAdapterImage)
+
+	adapter, err := r.getOwnedKnativeService(ctx, args.owner)
+	switch {
+	case apierrors.IsNotFound(err):
+		adapter, err = r.ksvcCli(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("creating receive adapter: %w", err)
+		}
+		return &adapterStatus{ready: adapter.IsReady(), url: adapter.Status.URL, service: adapter.Name}, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("searching for existing receive adapter: %w", err)
+	}
+
+	status := &adapterStatus{}
+
+	desiredSpec := knativeServiceAdapterSpec(desired)
+	existingSpec := knativeServiceAdapterSpec(adapter)
+
+	diff := adapterDiff(desiredSpec, existingSpec)
+
+	if hasString(diff, "image") && !r.canUpgrade(adapter.UID) {
+		// the other attributes are updated regardless, with the image
+		// which is currently running
+		desiredSpec.pod.Containers[0].Image = existingSpec.pod.Containers[0].Image
+		diff = adapterDiff(desiredSpec, existingSpec)
+		status.upgradeDeferred = true
+	}
+
+	if len(diff) > 0 {
+		updated := adapter.DeepCopy()
+		applyAdapterSpec(desiredSpec, knativeServiceAdapterSpec(updated))
+
+		adapter, err = r.ksvcCli(updated.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("updating receive adapter: %w", err)
+		}
+
+		controller.GetEventRecorder(ctx).Eventf(args.owner, corev1.EventTypeNormal, "AdapterUpdated",
+			"Updated receive adapter Service %q: %s", adapter.Name, strings.Join(diff, ", "))
+	}
+
+	status.ready = adapter.IsReady()
+	status.url = adapter.Status.URL
+	status.service = adapter.Name
+
+	switch {
+	case status.upgradeDeferred:
+		status.outOfDate = "Upgrade of the receive adapter image postponed, too many adapters are being upgraded"
+	case !isRolledOut(adapter):
+		status.outOfDate = "Receive adapter Service is rolling out its latest configuration"
+	default:
+		r.upgradeDone(adapter.UID)
+	}
+
+	return status, nil
+}
+
+// deleteKnativeServiceAdapter deletes the receive adapter Knative Service
+// owned by the given source, if any.
+func (r *adapterReconciler) deleteKnativeServiceAdapter(ctx context.Context, owner adapterOwner) error {
+	if r.ksvcLister == nil {
+		return nil
+	}
+
+	ownerMeta := owner.GetObjectMeta()
+
+	ksvcs, err := r.ksvcLister.Services(ownerMeta.GetNamespace()).List(labels.SelectorFromSet(adapterLabels))
+	if err != nil {
+		return err
+	}
+
+	for _, ksvc := range ksvcs {
+		if !metav1.IsControlledBy(ksvc, ownerMeta) {
+			continue
+		}
+		err := r.ksvcCli(ksvc.Namespace).Delete(ctx, ksvc.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		r.upgradeDone(ksvc.UID)
+	}
+
+	return nil
+}
+
+// adapterSpec references the attributes of a receive adapter object which
+// are managed by the controller.
+type adapterSpec struct {
+	// Metadata of the adapter object and of its Pod template.
+	meta     *metav1.ObjectMeta
+	template *metav1.ObjectMeta
+
+	pod *corev1.PodSpec
+}
+
+// managedAttributesAnnotation is set on receive adapter objects to the
+// attributes which were set by the controller, so that the ones which are no
+// longer desired are removed, while the ones defaulted by Kubernetes or
+// Knative Serving are preserved.
+const managedAttributesAnnotation = "sources.knative.dev/managed-attributes"
+
+// managedAttributes are the attributes of a receive adapter which were set by
+// the controller: the keys of its labels and of the labels and annotations of
+// its Pod template, and the names of the optional Pod attributes.
+type managedAttributes struct {
+	Labels         []string `json:"labels,omitempty"`
+	PodLabels      []string `json:"podLabels,omitempty"`
+	PodAnnotations []string `json:"podAnnotations,omitempty"`
+	Fields         []string `json:"fields,omitempty"`
+}
+
+// Names of the optional Pod attributes of receive adapters.
+const (
+	fieldNodeSelector       = "nodeSelector"
+	fieldAffinity           = "affinity"
+	fieldTolerations        = "tolerations"
+	fieldPodSecurityContext = "podSecurityContext"
+	fieldPorts              = "ports"
+	fieldResources          = "resources"
+	fieldSecurityContext    = "securityContext"
+)
+
+// desiredManagedAttributes returns the attributes set in the given desired
+// receive adapter.
+func desiredManagedAttributes(desired adapterSpec) managedAttributes {
+	attrs := managedAttributes{
+		Labels:         sets.List(sets.KeySet(desired.meta.Labels)),
+		PodLabels:      sets.List(sets.KeySet(desired.template.Labels)),
+		PodAnnotations: sets.List(sets.KeySet(desired.template.Annotations)),
+	}
+
+	pod := desired.pod
+	cont := &pod.Containers[0]
+
+	for field, isSet := range map[string]bool{
+		fieldNodeSelector:       len(pod.NodeSelector) > 0,
+		fieldAffinity:           pod.Affinity != nil,
+		fieldTolerations:        len(pod.Tolerations) > 0,
+		fieldPodSecurityContext: pod.SecurityContext != nil,
+		fieldPorts:              len(cont.Ports) > 0,
+		fieldResources:          len(cont.Resources.Limits) > 0 || len(cont.Resources.Requests) > 0,
+		fieldSecurityContext:    cont.SecurityContext != nil,
+	} {
+		if isSet {
+			attrs.Fields = append(attrs.Fields, field)
+		}
+	}
+	sort.Strings(attrs.Fields)
+
+	return attrs
+}
+
+// recordManagedAttributes records the attributes set in the given desired
+// receive adapter in its managedAttributesAnnotation.
+func recordManagedAttributes(desired adapterSpec) {
+	// marshaling a struct of strings can't fail
+	data, _ := json.Marshal(desiredManagedAttributes(desired))
+	desired.meta.Annotations = mergeMaps(desired.meta.Annotations, map[string]string{
+		managedAttributesAnnotation: string(data),
+	})
+}
+
+// recordedManagedAttributes returns the attributes recorded in the
+// managedAttributesAnnotation of the given existing receive adapter. Nothing
+// is recorded for adapters created by previous versions of the controller.
+func recordedManagedAttributes(existing adapterSpec) managedAttributes {
+	var attrs managedAttributes
+	if data, ok := existing.meta.Annotations[managedAttributesAnnotation]; ok {
+		_ = json.Unmarshal([]byte(data), &attrs)
+	}
+	return attrs
+}
+
+// adapterDiff returns the names of the attributes managed by the controller
+// which differ between the desired and the existing receive adapter.
+//
+// Attributes are managed by the controller when they are desired, or when
+// they were set by the controller previously, so that attributes removed from
+// the desired state are removed from the existing adapter. Attributes
+// defaulted by Kubernetes or Knative Serving are ignored.
+func adapterDiff(desired, existing adapterSpec) []string {
+	var diff []string
+
+	recorded := recordedManagedAttributes(existing)
+
+	if desired.meta.Annotations[managedAttributesAnnotation] != existing.meta.Annotations[managedAttributesAnnotation] {
+		diff = append(diff, "managedAttributes")
+	}
+	if !ownedKeysEqual(desired.meta.Labels, existing.meta.Labels, recorded.Labels) {
+		diff = append(diff, "labels")
+	}
+	if !ownedKeysEqual(desired.template.Labels, existing.template.Labels, recorded.PodLabels) {
+		diff = append(diff, "podLabels")
+	}
+	if !ownedKeysEqual(desired.template.Annotations, existing.template.Annotations, recorded.PodAnnotations) {
+		diff = append(diff, "podAnnotations")
+	}
+
+	owned := sets.New(recorded.Fields...).Insert(desiredManagedAttributes(desired).Fields...)
+	differs := func(field string, desired, existing any) bool {
+		return owned.Has(field) && !equality.Semantic.DeepEqual(desired, existing)
+	}
+
+	desiredPod, existingPod := desired.pod, existing.pod
+
+	if desiredPod.ServiceAccountName != existingPod.ServiceAccountName {
+		diff = append(diff, "serviceAccountName")
+	}
+	if differs(fieldNodeSelector, desiredPod.NodeSelector, existingPod.NodeSelector) ||
+		differs(fieldAffinity, desiredPod.Affinity, existingPod.Affinity) ||
+		differs(fieldTolerations, desiredPod.Tolerations, existingPod.Tolerations) {
+		diff = append(diff, "scheduling")
+	}
+	// Kubernetes defaults the security context of Pods to an empty one
+	if differs(fieldPodSecurityContext, podSecurityContextOrEmpty(desiredPod.SecurityContext),
+		podSecurityContextOrEmpty(existingPod.SecurityContext)) {
+		diff = append(diff, "podSecurityContext")
+	}
+
+	if len(existingPod.Containers) != 1 {
+		return append(diff, "containers")
+	}
+
+	desiredCont, existingCont := &desiredPod.Containers[0], &existingPod.Containers[0]
+
+	if desiredCont.Image != existingCont.Image {
+		diff = append(diff, "image")
+	}
+	if !equality.Semantic.DeepEqual(desiredCont.Env, existingCont.Env) {
+		diff = append(diff, "env")
+	}
+	if differs(fieldPorts, desiredCont.Ports, existingCont.Ports) {
+		diff = append(diff, "ports")
+	}
+	if differs(fieldResources, desiredCont.Resources, existingCont.Resources) {
+		diff = append(diff, "resources")
+	}
+	if differs(fieldSecurityContext, desiredCont.SecurityContext, existingCont.SecurityContext) {
+		diff = append(diff, "securityContext")
+	}
+
+	return diff
+}
+
+// applyAdapterSpec applies the attributes managed by the controller from the
+// desired receive adapter to the existing one, removing the ones which were
+// set by the controller but are no longer desired, and preserving the ones
+// which are defaulted by Kubernetes or Knative Serving.
+func applyAdapterSpec(desired, existing adapterSpec) {
+	recorded := recordedManagedAttributes(existing)
+
+	existing.meta.Annotations = mergeMaps(existing.meta.Annotations, map[string]string{
+		managedAttributesAnnotation: desired.meta.Annotations[managedAttributesAnnotation],
+	})
+	existing.meta.Labels = applyOwnedKeys(existing.meta.Labels, desired.meta.Labels, recorded.Labels)
+	existing.template.Labels = applyOwnedKeys(existing.template.Labels, desired.template.Labels, recorded.PodLabels)
+	existing.template.Annotations = applyOwnedKeys(existing.template.Annotations,
+		desired.template.Annotations, recorded.PodAnnotations)
+
+	desiredPod, existingPod := desired.pod, existing.pod
+
+	existingPod.ServiceAccountName = desiredPod.ServiceAccountName
+	existingPod.NodeSelector = desiredPod.NodeSelector
+	existingPod.Affinity = desiredPod.Affinity
+	existingPod.Tolerations = desiredPod.Tolerations
+	existingPod.SecurityContext = desiredPod.SecurityContext
+
+	if len(existingPod.Containers) != 1 {
+		existingPod.Containers = desiredPod.Containers
+		return
+	}
+
+	existingCont, desiredCont := &existingPod.Containers[0], &desiredPod.Containers[0]
+
+	existingCont.Image = desiredCont.Image
+	existingCont.Env = desiredCont.Env
+	existingCont.Ports = desiredCont.Ports
+	existingCont.Resources = desiredCont.Resources
+	existingCont.SecurityContext = desiredCont.SecurityContext
+}
+
+// ownedKeysEqual returns whether the entries of the existing map whose keys
+// are either desired or were recorded as set by the controller equal the
+// desired entries.
+func ownedKeysEqual(desired, existing map[string]string, recorded []string) bool {
+	for k, v := range desired {
+		if ev, ok := existing[k]; !ok || ev != v {
+			return false
+		}
+	}
+	for _, k := range recorded {
+		if _, isDesired := desired[k]; isDesired {
+			continue
+		}
+		if _, exists := existing[k]; exists {
+			return false
+		}
+	}
+	return true
+}
+
+// applyOwnedKeys sets the desired entries in the existing map, removes the
+// entries whose keys were recorded as set by the controller but are no longer
+// desired, and returns the resulting map.
+func applyOwnedKeys(existing, desired map[string]string, recorded []string) map[string]string {
+	for _, k := range recorded {
+		if _, isDesired := desired[k]; !isDesired {
+			delete(existing, k)
+		}
+	}
+	return mergeMaps(existing, desired)
+}
+
+// podSecurityContextOrEmpty returns the given security context, or an empty
+// one if it is nil.
+func podSecurityContextOrEmpty(sc *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	if sc == nil {
+		return &corev1.PodSecurityContext{}
+	}
+	return sc
+}
+
+// mergeMaps sets the entries of src in dst, which is allocated if necessary,
+// and returns dst.
+func mergeMaps(dst, src map[string]string) map[string]string {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// applyAdapterTemplate applies the customizations of the given template to
+// the generated Pod template of a receive adapter. Labels and annotations set
+// by the controller take precedence over the ones of the template.
+func applyAdapterTemplate(tmpl *v1beta1.AdapterTemplate, meta *metav1.ObjectMeta, pod *corev1.PodSpec) {
+	if tmpl == nil {
+		return
+	}
+
+	meta.Labels = mergeMaps(mergeMaps(nil, tmpl.Labels), meta.Labels)
+	meta.Annotations = mergeMaps(mergeMaps(nil, tmpl.Annotations), meta.Annotations)
+
+	pod.NodeSelector = tmpl.NodeSelector
+	pod.Affinity = tmpl.Affinity
+	pod.Tolerations = tmpl.Tolerations
+	pod.SecurityContext = tmpl.PodSecurityContext
+
+	pod.Containers[0].Resources = tmpl.Resources
+	pod.Containers[0].SecurityContext = tmpl.SecurityContext
+}
+
+// adapterUpgrades are the receive adapters which are being upgraded to a new
+// image, shared by the reconcilers of all kinds of sources.
+var adapterUpgrades = newUpgradeSet()
+
+// upgradeSet is a set of receive adapters, identified by their UID, which
+// were allowed to roll out a new image, and whose rollout wasn't observed
+// yet.
+//
+// Listers lag behind the updates of adapters, so that an adapter which was
+// just updated may still appear rolled out in the cache. Counting the adapters
+// of the set prevents more adapters than allowed from being upgraded
+// meanwhile.
+type upgradeSet struct {
+	mu       sync.Mutex
+	inFlight sets.Set[types.UID]
+}
+
+func newUpgradeSet() *upgradeSet {
+	return &upgradeSet{
+		inFlight: sets.New[types.UID](),
+	}
+}
+
+// canUpgrade returns whether the receive adapter with the given UID can roll
+// out a new image, given the number of adapters which are already rolling
+// out, or were allowed to. Adapters which are allowed to are added to the set
+// of in-flight upgrades, until upgradeDone is called.
+func (r *adapterReconciler) canUpgrade(uid types.UID) bool {
+	if r.maxConcurrentUpgrades <= 0 {
+		return true
+	}
+
+	r.upgrades.mu.Lock()
+	defer r.upgrades.mu.Unlock()
+
+	if r.upgrades.inFlight.Has(uid) {
+		return true
+	}
+
+	rollingOut := r.upgrades.inFlight.Clone()
+
+	if r.ksvcLister != nil {
+		ksvcs, err := r.ksvcLister.List(labels.SelectorFromSet(adapterLabels))
+		if err != nil {
+			return false
+		}
+		for _, ksvc := range ksvcs {
+			if ksvc.UID != uid && !isRolledOut(ksvc) {
+				rollingOut.Insert(ksvc.UID)
+			}
+		}
+	}
+
+	deployments, err := r.deploymentLister.List(labels.SelectorFromSet(adapterLabels))
+	if err != nil {
+		return false
+	}
+	for _, d := range deployments {
+		if d.UID != uid && !isDeploymentRolledOut(d) {
+			rollingOut.Insert(d.UID)
+		}
+	}
+
+	if rollingOut.Len() >= r.maxConcurrentUpgrades {
+		return false
+	}
+
+	r.upgrades.inFlight.Insert(uid)
+	return true
+}
+
+// upgradeDone removes the receive adapter with the given UID from the set of
+// in-flight upgrades, once its rollout was observed, or it was deleted.
+func (r *adapterReconciler) upgradeDone(uid types.UID) {
+	if r.upgrades == nil {
+		return
+	}
+
+	r.upgrades.mu.Lock()
+	defer r.upgrades.mu.Unlock()
+
+	r.upgrades.inFlight.Delete(uid)
+}
+
+// isRolledOut returns whether the latest configuration of the given Knative
+// Service is ready to serve requests.
+func isRolledOut(ksvc *servingv1.Service) bool {
+	return ksvc.Status.ObservedGeneration == ksvc.Generation &&
+		ksvc.Status.LatestCreatedRevisionName == ksvc.Status.LatestReadyRevisionName
+}
+
+// hasString returns whether the given slice contains the given string.
+func hasString(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// adapterPodAnnotations returns the annotations of the receive adapter's
+// Pods.
+func adapterPodAnnotations(args *adapterArgs) map[string]string {
+	if args.secretTokenHash == "" {
+		return nil
+	}
+	return map[string]string{
+		secretTokenHashAnnotation: args.secretTokenHash,
+	}
+}
+
+// adapterEnv returns the environment variables of the receive adapter's
+// container.
+func (r *adapterReconciler) adapterEnv(args *adapterArgs) []corev1.EnvVar {
+	env := append([]corev1.EnvVar{
+		{
+			Name: "GITLAB_SECRET_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: args.secretToken,
+			},
+		}, {
+			Name:  "GITLAB_EVENT_SOURCE",
+			Value: args.eventSource,
+		}, {
+			Name:  "GITLAB_EVENT_SOURCE_FROM_PAYLOAD",
+			Value: strconv.FormatBool(args.eventSourceFromPayload),
+		}, {
+			Name:  "K_SINK",
+			Value: args.sinkURI.String(),
+		}, {
+			Name:  "NAMESPACE",
+			Value: args.owner.GetObjectMeta().GetNamespace(),
+		}, {
+			Name:  "METRICS_DOMAIN",
+			Value: "knative.dev/eventing",
+		}, {
+			Name:  "METRICS_PROMETHEUS_PORT",
+			Value: "9092",
+		}},
+		r.configs.ToEnvVars()...)
+
+	if args.previousSecretToken != nil {
+		env = append(env, corev1.EnvVar{
+			Name: "GITLAB_PREVIOUS_SECRET_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: args.previousSecretToken,
+			},
+		})
+	}
+
+	return env
+}
+
+// newAdapterLabels returns the labels of a receive adapter.
+func newAdapterLabels() map[string]string {
+	l := make(map[string]string, len(adapterLabels))
+	for k, v := range adapterLabels {
+		l[k] = v
+	}
+	return l
+}
+
+// knativeServiceAdapterSpec returns the attributes of the given receive
+// adapter Knative Service which are managed by the controller.
+func knativeServiceAdapterSpec(ksvc *servingv1.Service) adapterSpec {
+	return adapterSpec{
+		meta:     &ksvc.ObjectMeta,
+		template: &ksvc.Spec.Template.ObjectMeta,
+		pod:      &ksvc.Spec.Template.Spec.PodSpec,
+	}
+}
+
+func (r *adapterReconciler) generateKnativeServiceObject(args *adapterArgs, receiveAdapterImage string) *servingv1.Service {
+	owner := args.owner.GetObjectMeta()
+
+	ksvc := &servingv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-", owner.GetName()),
+			Namespace:    owner.GetNamespace(),
+			Labels:       newAdapterLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(args.owner),
+			},
+		},
+		Spec: servingv1.ServiceSpec{
+			ConfigurationSpec: servingv1.ConfigurationSpec{
+				Template: servingv1.RevisionTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: adapterPodAnnotations(args),
+					},
+					Spec: servingv1.RevisionSpec{
+						PodSpec: corev1.PodSpec{
+							ServiceAccountName: args.serviceAccountName,
+							Containers: []corev1.Container{
+								{
+									Image: receiveAdapterImage,
+									Env:   r.adapterEnv(args),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	applyAdapterTemplate(args.template, &ksvc.Spec.Template.ObjectMeta, &ksvc.Spec.Template.Spec.PodSpec)
+	recordManagedAttributes(knativeServiceAdapterSpec(ksvc))
+
+	return ksvc
+}
+
+func (r *adapterReconciler) getOwnedKnativeService(ctx context.Context, owner kmeta.OwnerRefable) (*servingv1.Service, error) {
+	ownerMeta := owner.GetObjectMeta()
+
+	list, err := r.ksvcCli(ownerMeta.GetNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Everything().String(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	for _, ksvc := range list.Items {
+		if metav1.IsControlledBy(&ksvc, ownerMeta) {
+			return &ksvc, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(servingv1.Resource("services"), "")
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/network"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// adapterInstanceLabel is the label which selects the Pods of a single
+	// receive adapter running as a Deployment.
+	adapterInstanceLabel = "receive-adapter-uid"
+
+	// adapterPort is the port the receive adapter listens on.
+	adapterPort = 8080
+)
+
+// reconcileDeploymentAdapter reconciles a receive adapter running as a
+// Deployment, exposed inside the cluster by a Service.
+// Existing adapters are updated whenever the attributes managed by the
+// controller differ from their desired state.
+func (r *adapterReconciler) reconcileDeploymentAdapter(ctx context.Context, args *adapterArgs) (*adapterStatus, error) {
+	desired := r.generateDeployment(args, r.receiveAdapterImage)
+
+	status := &adapterStatus{}
+
+	adapter, err := r.deploymentLister.Deployments(desired.Namespace).Get(desired.Name)
+	switch {
+	case apierrors.IsNotFound(err):
+		adapter, err = r.deploymentCli(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("creating receive adapter Deployment: %w", err)
+		}
+
+	case err != nil:
+		return nil, fmt.Errorf("getting receive adapter Deployment: %w", err)
+
+	case !metav1.IsControlledBy(adapter, args.owner.GetObjectMeta()):
+		return nil, fmt.Errorf("deployment %q already exists and is not owned by the source", desired.Name)
+
+	default:
+		desiredSpec := deploymentAdapterSpec(desired)
+		existingSpec := deploymentAdapterSpec(adapter)
+
+		diff := adapterDiff(desiredSpec, existingSpec)
+
+		if hasString(diff, "image") && !r.canUpgrade(adapter.UID) {
+			// the other attributes are updated regardless, with the
+			// image which is currently running
+			desiredSpec.pod.Containers[0].Image = existingSpec.pod.Containers[0].Image
+			diff = adapterDiff(desiredSpec, existingSpec)
+			status.upgradeDeferred = true
+		}
+
+		if len(diff) > 0 {
+			updated := adapter.DeepCopy()
+			applyAdapterSpec(desiredSpec, deploymentAdapterSpec(updated))
+
+			adapter, err = r.deploymentCli(updated.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("updating receive adapter Deployment: %w", err)
+			}
+
+			controller.GetEventRecorder(ctx).Eventf(args.owner, corev1.EventTypeNormal, "AdapterUpdated",
+				"Updated receive adapter Deployment %q: %s", adapter.Name, strings.Join(diff, ", "))
+		}
+	}
+
+	svc, err := r.reconcileAdapterService(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	status.ready = isDeploymentAvailable(adapter)
+	status.url = &apis.URL{
+		Scheme: "http",
+		Host:   network.GetServiceHostname(svc.Name, svc.Namespace),
+	}
+	status.service = svc.Name
+
+	switch {
+	case status.upgradeDeferred:
+		status.outOfDate = "Upgrade of the receive adapter image postponed, too many adapters are being upgraded"
+	case !isDeploymentRolledOut(adapter):
+		status.outOfDate = "Receive adapter Deployment is rolling out its latest configuration"
+	default:
+		r.upgradeDone(adapter.UID)
+	}
+
+	return status, nil
+}
+
+// reconcileAdapterService reconciles the Service which exposes a receive
+// adapter running as a Deployment.
+func (r *adapterReconciler) reconcileAdapterService(ctx context.Context, args *adapterArgs) (*corev1.Service, error) {
+	desired := generateAdapterService(args)
+
+	svc, err := r.serviceCli(desired.Namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		svc, err = r.serviceCli(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("creating receive adapter Service: %w", err)
+		}
+
+	case err != nil:
+		return nil, fmt.Errorf("getting receive adapter Service: %w", err)
+
+	case !metav1.IsControlledBy(svc, args.owner.GetObjectMeta()):
+		return nil, fmt.Errorf("service %q already exists and is not owned by the source", desired.Name)
+
+	case !equality.Semantic.DeepEqual(desired.Spec.Selector, svc.Spec.Selector) ||
+		!equality.Semantic.DeepEqual(desired.Spec.Ports, svc.Spec.Ports):
+
+		updated := svc.DeepCopy()
+		updated.Spec.Selector = desired.Spec.Selector
+		updated.Spec.Ports = desired.Spec.Ports
+
+		svc, err = r.serviceCli(updated.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("updating receive adapter Service: %w", err)
+		}
+	}
+
+	return svc, nil
+}
+
+// deleteDeploymentAdapter deletes the receive adapter Deployment owned by the
+// given source, if any, together with its Service.
+func (r *adapterReconciler) deleteDeploymentAdapter(ctx context.Context, owner adapterOwner) error {
+	ownerMeta := owner.GetObjectMeta()
+	name := deploymentAdapterName(owner)
+
+	d, err := r.deploymentLister.Deployments(ownerMeta.GetNamespace()).Get(name)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	case !metav1.IsControlledBy(d, ownerMeta):
+		return nil
+	}
+
+	err = r.serviceCli(d.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	err = r.deploymentCli(d.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	r.upgradeDone(d.UID)
+
+	return nil
+}
+
+// deploymentAdapterName returns the name of the Deployment and Service of the
+// receive adapter of the given source.
+func deploymentAdapterName(owner kmeta.OwnerRefable) string {
+	return kmeta.ChildName(owner.GetObjectMeta().GetName(), "-adapter")
+}
+
+// deploymentAdapterSelector returns the labels which select the Pods of the
+// receive adapter of the given source.
+func deploymentAdapterSelector(owner kmeta.OwnerRefable) map[string]string {
+	return map[string]string{
+		adapterInstanceLabel: string(owner.GetObjectMeta().GetUID()),
+	}
+}
+
+// deploymentAdapterSpec returns the attributes of the given receive adapter
+// Deployment which are managed by the controller.
+func deploymentAdapterSpec(d *appsv1.Deployment) adapterSpec {
+	return adapterSpec{
+		meta:     &d.ObjectMeta,
+		template: &d.Spec.Template.ObjectMeta,
+		pod:      &d.Spec.Template.Spec,
+	}
+}
+
+func (r *adapterReconciler) generateDeployment(args *adapterArgs, receiveAdapterImage string) *appsv1.Deployment {
+	owner := args.owner.GetObjectMeta()
+
+	podLabels := newAdapterLabels()
+	for k, v := range deploymentAdapterSelector(args.owner) {
+		podLabels[k] = v
+	}
+
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploymentAdapterName(args.owner),
+			Namespace: owner.GetNamespace(),
+			Labels:    newAdapterLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(args.owner),
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: deploymentAdapterSelector(args.owner),
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: adapterPodAnnotations(args),
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: args.serviceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:  "receive-adapter",
+							Image: receiveAdapterImage,
+							Env:   r.adapterEnv(args),
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "http",
+									ContainerPort: adapterPort,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									TCPSocket: &corev1.TCPSocketAction{
+										Port: intstr.FromInt(adapterPort),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	applyAdapterTemplate(args.template, &d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec)
+	recordManagedAttributes(deploymentAdapterSpec(d))
+
+	return d
+}
+
+func generateAdapterService(args *adapterArgs) *corev1.Service {
+	owner := args.owner.GetObjectMeta()
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploymentAdapterName(args.owner),
+			Namespace: owner.GetNamespace(),
+			Labels:    newAdapterLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(args.owner),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: deploymentAdapterSelector(args.owner),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       80,
+					TargetPort: intstr.FromInt(adapterPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+}
+
+// isDeploymentAvailable returns whether the given Deployment has the minimum
+// number of available replicas.
+func isDeploymentAvailable(d *appsv1.Deployment) bool {
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// isDeploymentRolledOut returns whether all replicas of the given Deployment
+// run its latest Pod template.
+func isDeploymentRolledOut(d *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+
+	return d.Status.ObservedGeneration >= d.Generation &&
+		d.Status.UpdatedReplicas == replicas &&
+		d.Status.Replicas == replicas &&
+		d.Status.AvailableReplicas == replicas
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	networkingclientv1 "k8s.io/client-go/kubernetes/typed/networking/v1"
+	networkinglistersv1 "k8s.io/client-go/listers/networking/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+// httpRouteResource is the Gateway API resource of HTTPRoutes.
+var httpRouteResource = gatewayv1.SchemeGroupVersion.WithResource("httproutes")
+
+// ingressUpstreamVhostAnnotation instructs ingress-nginx to forward requests
+// with the internal hostname of the receive adapter, which Knative Services
+// are routed by.
+const ingressUpstreamVhostAnnotation = "nginx.ingress.kubernetes.io/upstream-vhost"
+
+// exposureReconciler reconciles the objects which expose receive adapters
+// outside of the cluster.
+type exposureReconciler struct {
+	ingressCli    func(namespace string) networkingclientv1.IngressInterface
+	ingressLister networkinglistersv1.IngressLister
+
+	// Clients for HTTPRoutes. Nil when the Gateway API isn't installed in
+	// the cluster.
+	routeCli    dynamic.NamespaceableResourceInterface
+	routeLister cache.GenericLister
+
+	// Exposure of receive adapters whose source doesn't specify one, and
+	// default values of the attributes of exposures. Adapters aren't
+	// exposed by default when its type is empty.
+	defaultExposure *v1beta1.AdapterExposure
+	// Domain of the hostnames of exposed adapters.
+	exposureDomain string
+
+	// Public URL of the shared receive adapter, if it is exposed.
+	sharedAdapterURL *apis.URL
+}
+
+// exposeAdapter exposes the given receive adapter outside of the cluster
+// according to the source's exposure, and returns its status with the
+// public URL of the adapter. Objects which exposed the adapter in a
+// different way are removed.
+func (r *adapterReconciler) exposeAdapter(ctx context.Context, args *adapterArgs,
+	adapter *adapterStatus) (*adapterStatus, error) {
+
+	exposure, err := r.exposureFor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var exposed v1beta1.ExposureType
+	if exposure != nil {
+		exposed = exposure.Type
+	}
+	if err := r.deleteExposure(ctx, args.owner, exposed); err != nil {
+		return nil, fmt.Errorf("deleting receive adapter exposure: %w", err)
+	}
+
+	switch {
+	case args.webhookURL != nil:
+		adapter.url = args.webhookURL.DeepCopy()
+		return adapter, nil
+
+	case exposure == nil || adapter.url == nil:
+		return adapter, nil
+
+	case adapter.service == "":
+		// the shared receive adapter is exposed once for all sources
+		if args.exposure != nil {
+			return nil, fmt.Errorf("the shared receive adapter can not be exposed per source")
+		}
+		return adapter, nil
+	}
+
+	switch exposure.Type {
+	case v1beta1.ExposureTypeIngress:
+		err = r.reconcileIngress(ctx, args, exposure, adapter)
+	case v1beta1.ExposureTypeHTTPRoute:
+		err = r.reconcileHTTPRoute(ctx, args, exposure, adapter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := "https"
+	if exposure.Type == v1beta1.ExposureTypeIngress && exposure.TLSSecretName == "" {
+		scheme = "http"
+	}
+
+	adapter.url = &apis.URL{
+		Scheme: scheme,
+		Host:   exposure.Host,
+		Path:   exposure.Path,
+	}
+
+	return adapter, nil
+}
+
+// exposureFor returns the exposure of the receive adapter of the given
+// source, with defaults applied, or nil if the adapter isn't exposed.
+func (r *adapterReconciler) exposureFor(args *adapterArgs) (*v1beta1.AdapterExposure, error) {
+	if args.webhookURL != nil {
+		return nil, nil
+	}
+
+	var exposure *v1beta1.AdapterExposure
+	switch {
+	case args.exposure != nil:
+		exposure = args.exposure.DeepCopy()
+	case r.defaultExposure.Type != "":
+		exposure = r.defaultExposure.DeepCopy()
+	default:
+		return nil, nil
+	}
+
+	owner := args.owner.GetObjectMeta()
+
+	if exposure.Host == "" {
+		if r.exposureDomain == "" {
+			return nil, fmt.Errorf("no host is set for the %s exposure of the receive adapter, "+
+				"and no default domain is configured", exposure.Type)
+		}
+		exposure.Host = fmt.Sprintf("%s-%s.%s", owner.GetName(), owner.GetNamespace(), r.exposureDomain)
+	}
+	if exposure.Path == "" {
+		exposure.Path = "/"
+	}
+
+	switch exposure.Type {
+	case v1beta1.ExposureTypeIngress:
+		if exposure.IngressClassName == "" {
+			exposure.IngressClassName = r.defaultExposure.IngressClassName
+		}
+		if exposure.TLSSecretName == "" {
+			exposure.TLSSecretName = r.defaultExposure.TLSSecretName
+		}
+	case v1beta1.ExposureTypeHTTPRoute:
+		if exposure.Gateway == nil {
+			exposure.Gateway = r.defaultExposure.Gateway.DeepCopy()
+		}
+	}
+
+	return exposure, nil
+}
+
+// reconcileIngress reconciles the Ingress which exposes the given receive
+// adapter.
+func (r *adapterReconciler) reconcileIngress(ctx context.Context, args *adapterArgs,
+	exposure *v1beta1.AdapterExposure, adapter *adapterStatus) error {
+
+	desired := generateIngress(args, exposure, adapter)
+
+	ing, err := r.ingressLister.Ingresses(desired.Namespace).Get(desired.Name)
+	switch {
+	case apierrors.IsNotFound(err):
+		if _, err := r.ingressCli(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating receive adapter Ingress: %w", err)
+		}
+
+	case err != nil:
+		return fmt.Errorf("getting receive adapter Ingress: %w", err)
+
+	case !metav1.IsControlledBy(ing, args.owner.GetObjectMeta()):
+		return fmt.Errorf("ingress %q already exists and is not owned by the source", desired.Name)
+
+	case !equality.Semantic.DeepEqual(desired.Spec, ing.Spec) ||
+		!equality.Semantic.DeepDerivative(desired.Annotations, ing.Annotations):
+
+		updated := ing.DeepCopy()
+		updated.Spec = desired.Spec
+		if updated.Annotations == nil {
+			updated.Annotations = make(map[string]string, len(desired.Annotations))
+		}
+		for k, v := range desired.Annotations {
+			updated.Annotations[k] = v
+		}
+
+		if _, err := r.ingressCli(updated.Namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("updating receive adapter Ingress: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileHTTPRoute reconciles the HTTPRoute which exposes the given receive
+// adapter.
+func (r *adapterReconciler) reconcileHTTPRoute(ctx context.Context, args *adapterArgs,
+	exposure *v1beta1.AdapterExposure, adapter *adapterStatus) error {
+
+	if r.routeCli == nil {
+		return fmt.Errorf("the Gateway API is not installed, the receive adapter can not be exposed by an HTTPRoute")
+	}
+	if exposure.Gateway == nil {
+		return fmt.Errorf("no Gateway is set for the HTTPRoute exposure of the receive adapter, " +
+			"and no default Gateway is configured")
+	}
+
+	desired := generateHTTPRoute(args, exposure, adapter)
+
+	obj, err := r.routeLister.ByNamespace(desired.Namespace).Get(desired.Name)
+	switch {
+	case apierrors.IsNotFound(err):
+		u, err := toUnstructured(desired)
+		if err != nil {
+			return err
+		}
+		if _, err := r.routeCli.Namespace(desired.Namespace).Create(ctx, u, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating receive adapter HTTPRoute: %w", err)
+		}
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("getting receive adapter HTTPRoute: %w", err)
+	}
+
+	route := &gatewayv1.HTTPRoute{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, route); err != nil {
+		return fmt.Errorf("converting receive adapter HTTPRoute: %w", err)
+	}
+
+	switch {
+	case !metav1.IsControlledBy(route, args.owner.GetObjectMeta()):
+		return fmt.Errorf("HTTPRoute %q already exists and is not owned by the source", desired.Name)
+
+	case !equality.Semantic.DeepDerivative(desired.Spec, route.Spec):
+		route.Spec = desired.Spec
+
+		u, err := toUnstructured(route)
+		if err != nil {
+			return err
+		}
+		if _, err := r.routeCli.Namespace(route.Namespace).Update(ctx, u, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("updating receive adapter HTTPRoute: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteExposure deletes the objects owned by the given source which expose
+// its receive adapter, except the ones of the given type.
+func (r *adapterReconciler) deleteExposure(ctx context.Context, owner adapterOwner, keep v1beta1.ExposureType) error {
+	ownerMeta := owner.GetObjectMeta()
+	name := exposureName(owner)
+
+	if keep != v1beta1.ExposureTypeIngress {
+		ing, err := r.ingressLister.Ingresses(ownerMeta.GetNamespace()).Get(name)
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return err
+		case metav1.IsControlledBy(ing, ownerMeta):
+			err := r.ingressCli(ing.Namespace).Delete(ctx, ing.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	if keep != v1beta1.ExposureTypeHTTPRoute && r.routeLister != nil {
+		obj, err := r.routeLister.ByNamespace(ownerMeta.GetNamespace()).Get(name)
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return err
+		case metav1.IsControlledBy(obj.(metav1.Object), ownerMeta):
+			err := r.routeCli.Namespace(ownerMeta.GetNamespace()).Delete(ctx, name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// exposureName returns the name of the objects which expose the receive
+// adapter of the given source.
+func exposureName(owner kmeta.OwnerRefable) string {
+	return kmeta.ChildName(owner.GetObjectMeta().GetName(), "-adapter")
+}
+
+func generateIngress(args *adapterArgs, exposure *v1beta1.AdapterExposure,
+	adapter *adapterStatus) *networkingv1.Ingress {
+
+	owner := args.owner.GetObjectMeta()
+
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exposureName(args.owner),
+			Namespace: owner.GetNamespace(),
+			Labels:    newAdapterLabels(),
+			Annotations: map[string]string{
+				ingressUpstreamVhostAnnotation: adapter.url.Host,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(args.owner),
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: exposure.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     exposure.Path,
+							PathType: ptr.To(networkingv1.PathTypePrefix),
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: adapter.service,
+									Port: networkingv1.ServiceBackendPort{Number: 80},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+
+	if exposure.IngressClassName != "" {
+		ing.Spec.IngressClassName = ptr.To(exposure.IngressClassName)
+	}
+
+	if exposure.TLSSecretName != "" {
+		ing.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{exposure.Host},
+			SecretName: exposure.TLSSecretName,
+		}}
+	}
+
+	return ing
+}
+
+func generateHTTPRoute(args *adapterArgs, exposure *v1beta1.AdapterExposure,
+	adapter *adapterStatus) *gatewayv1.HTTPRoute {
+
+	owner := args.owner.GetObjectMeta()
+
+	gatewayNamespace := exposure.Gateway.Namespace
+	if gatewayNamespace == "" {
+		gatewayNamespace = owner.GetNamespace()
+	}
+
+	return &gatewayv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1.SchemeGroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exposureName(args.owner),
+			Namespace: owner.GetNamespace(),
+			Labels:    newAdapterLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(args.owner),
+			},
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{
+					Namespace: ptr.To(gatewayv1.Namespace(gatewayNamespace)),
+					Name:      gatewayv1.ObjectName(exposure.Gateway.Name),
+				}},
+			},
+			Hostnames: []gatewayv1.Hostname{gatewayv1.Hostname(exposure.Host)},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+						Value: ptr.To(exposure.Path),
+					},
+				}},
+				// Knative Services are routed by their internal hostname
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+						Hostname: ptr.To(gatewayv1.PreciseHostname(adapter.url.Host)),
+					},
+				}},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: gatewayv1.ObjectName(adapter.service),
+							Port: ptr.To(gatewayv1.PortNumber(80)),
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+// toUnstructured converts the given HTTPRoute to an unstructured object.
+func toUnstructured(route *gatewayv1.HTTPRoute) (*unstructured.Unstructured, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(route)
+	if err != nil {
+		return nil, fmt.Errorf("converting receive adapter HTTPRoute: %w", err)
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/network"
+	"knative.dev/pkg/system"
+)
+
+// sharedAdapterName is the name of the Deployment and Service of the receive
+// adapter shared by all sources, in the system namespace.
+const sharedAdapterName = "gitlab-shared-adapter"
+
+// reconcileSharedAdapter returns the state of the shared receive adapter on
+// behalf of the given source. The shared adapter is deployed alongside the
+// controller, so only its availability is observed.
+func (r *adapterReconciler) reconcileSharedAdapter(args *adapterArgs) (*adapterStatus, error) {
+	d, err := r.deploymentLister.Deployments(system.Namespace()).Get(sharedAdapterName)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("shared receive adapter %s/%s is not deployed", system.Namespace(), sharedAdapterName)
+	case err != nil:
+		return nil, fmt.Errorf("getting shared receive adapter Deployment: %w", err)
+	}
+
+	owner := args.owner.GetObjectMeta()
+
+	// the shared adapter routes requests based on their path
+	url := &apis.URL{
+		Scheme: "http",
+		Host:   network.GetServiceHostname(sharedAdapterName, system.Namespace()),
+	}
+	if r.sharedAdapterURL != nil {
+		url = r.sharedAdapterURL.DeepCopy()
+	}
+	url.Path = strings.TrimSuffix(url.Path, "/") + "/" + owner.GetNamespace() + "/" + owner.GetName()
+
+	return &adapterStatus{
+		ready: isDeploymentAvailable(d),
+		url:   url,
+	}, nil
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	appslistersv1 "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+func TestAdapterDiff(t *testing.T) {
+	customized := &v1beta1.AdapterTemplate{
+		Labels:      map[string]string{"team": "a"},
+		Annotations: map[string]string{"autoscaling.knative.dev/min-scale": "1"},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+		},
+		SecurityContext:    &corev1.SecurityContext{RunAsNonRoot: ptrTo(true)},
+		PodSecurityContext: &corev1.PodSecurityContext{RunAsUser: ptrTo(int64(1000))},
+		NodeSelector:       map[string]string{"disk": "ssd"},
+		Tolerations:        []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+	}
+
+	without := func(remove func(*v1beta1.AdapterTemplate)) *v1beta1.AdapterTemplate {
+		tmpl := customized.DeepCopy()
+		remove(tmpl)
+		return tmpl
+	}
+
+	testCases := []struct {
+		name string
+		// Customizations of the existing and desired adapters.
+		existing, desired *v1beta1.AdapterTemplate
+		// Hashes of the secret token of the existing and desired adapters.
+		existingHash, desiredHash string
+		// Changes made to the existing adapter by Kubernetes or by users.
+		mutate func(*appsv1.Deployment)
+
+		expectDiff []string
+	}{
+		{
+			name:     "Adapter is up to date",
+			existing: customized,
+			desired:  customized,
+		},
+		{
+			name:     "Defaulted attributes are ignored",
+			existing: nil,
+			desired:  nil,
+			mutate: func(d *appsv1.Deployment) {
+				d.Labels["defaulted"] = "true"
+				d.Spec.Template.Annotations = map[string]string{"defaulted": "true"}
+				d.Spec.Template.Spec.SecurityContext = &corev1.PodSecurityContext{}
+				d.Spec.Template.Spec.Tolerations = []corev1.Toleration{{Key: "defaulted"}}
+			},
+		},
+		{
+			name:       "Removed node selector",
+			existing:   customized,
+			desired:    without(func(tmpl *v1beta1.AdapterTemplate) { tmpl.NodeSelector = nil }),
+			expectDiff: []string{"managedAttributes", "scheduling"},
+		},
+		{
+			name:       "Removed tolerations",
+			existing:   customized,
+			desired:    without(func(tmpl *v1beta1.AdapterTemplate) { tmpl.Tolerations = nil }),
+			expectDiff: []string{"managedAttributes", "scheduling"},
+		},
+		{
+			name:       "Removed resources",
+			existing:   customized,
+			desired:    without(func(tmpl *v1beta1.AdapterTemplate) { tmpl.Resources = corev1.ResourceRequirements{} }),
+			expectDiff: []string{"managedAttributes", "resources"},
+		},
+		{
+			name:       "Removed security contexts",
+			existing:   customized,
+			desired:    without(func(tmpl *v1beta1.AdapterTemplate) { tmpl.SecurityContext, tmpl.PodSecurityContext = nil, nil }),
+			expectDiff: []string{"managedAttributes", "podSecurityContext", "securityContext"},
+		},
+		{
+			name:       "Removed Pod label",
+			existing:   customized,
+			desired:    without(func(tmpl *v1beta1.AdapterTemplate) { tmpl.Labels = nil }),
+			expectDiff: []string{"managedAttributes", "podLabels"},
+		},
+		{
+			name:       "Removed min-scale annotation",
+			existing:   customized,
+			desired:    without(func(tmpl *v1beta1.AdapterTemplate) { tmpl.Annotations = nil }),
+			expectDiff: []string{"managedAttributes", "podAnnotations"},
+		},
+		{
+			name:         "Removed secret token hash",
+			existingHash: testSecretTokenHasher.Hash(testSecretToken),
+			expectDiff:   []string{"managedAttributes", "podAnnotations"},
+		},
+		{
+			name:     "Adapter created by previous versions",
+			existing: customized,
+			desired:  customized,
+			mutate: func(d *appsv1.Deployment) {
+				delete(d.Annotations, managedAttributesAnnotation)
+			},
+			expectDiff: []string{"managedAttributes"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestAdapterReconciler()
+
+			existing := r.generateDeployment(newTestAdapterArgs(tc.existing, tc.existingHash), testAdapterImage)
+			if tc.mutate != nil {
+				tc.mutate(existing)
+			}
+			desired := r.generateDeployment(newTestAdapterArgs(tc.desired, tc.desiredHash), testAdapterImage)
+
+			diff := adapterDiff(deploymentAdapterSpec(desired), deploymentAdapterSpec(existing))
+			assert.ElementsMatch(t, tc.expectDiff, diff)
+		})
+	}
+}
+
+func TestApplyAdapterSpec(t *testing.T) {
+	r := newTestAdapterReconciler()
+
+	existing := r.generateDeployment(newTestAdapterArgs(&v1beta1.AdapterTemplate{
+		Labels:       map[string]string{"team": "a"},
+		Annotations:  map[string]string{"autoscaling.knative.dev/min-scale": "1"},
+		NodeSelector: map[string]string{"disk": "ssd"},
+	}, testSecretTokenHasher.Hash(testSecretToken)), testAdapterImage)
+	existing.Spec.Template.Labels["defaulted"] = "true"
+	existing.Spec.Template.Annotations["defaulted"] = "true"
+
+	desired := r.generateDeployment(newTestAdapterArgs(nil, ""), testAdapterImage)
+
+	applyAdapterSpec(deploymentAdapterSpec(desired), deploymentAdapterSpec(existing))
+
+	pod := existing.Spec.Template
+	assert.NotContains(t, pod.Labels, "team", "removed Pod label")
+	assert.NotContains(t, pod.Annotations, "autoscaling.knative.dev/min-scale", "removed Pod annotation")
+	assert.NotContains(t, pod.Annotations, secretTokenHashAnnotation, "removed Pod annotation")
+	assert.Nil(t, pod.Spec.NodeSelector, "removed node selector")
+	assert.Equal(t, "true", pod.Labels["defaulted"], "defaulted Pod label")
+	assert.Equal(t, "true", pod.Annotations["defaulted"], "defaulted Pod annotation")
+
+	assert.Empty(t, adapterDiff(deploymentAdapterSpec(desired), deploymentAdapterSpec(existing)))
+}
+
+// TestReconcileDeploymentAdapterRemovedCustomizations ensures that the
+// customizations removed from the spec of a source are removed from its
+// receive adapter.
+func TestReconcileDeploymentAdapterRemovedCustomizations(t *testing.T) {
+	r := newTestAdapterReconciler()
+
+	existing := r.generateDeployment(newTestAdapterArgs(&v1beta1.AdapterTemplate{
+		Labels:       map[string]string{"team": "a"},
+		Annotations:  map[string]string{"example.com/owner": "team-a"},
+		NodeSelector: map[string]string{"disk": "ssd"},
+		Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+		Affinity:     &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+		},
+	}, ""), testAdapterImage)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(existing))
+
+	kc := fake.NewSimpleClientset(existing)
+	r.deploymentCli = kc.AppsV1().Deployments
+	r.deploymentLister = appslistersv1.NewDeploymentLister(indexer)
+	r.serviceCli = kc.CoreV1().Services
+
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	_, err := r.reconcileDeploymentAdapter(ctx, newTestAdapterArgs(nil, ""))
+	require.NoError(t, err)
+
+	updated, err := kc.AppsV1().Deployments(testNamespace).Get(ctx, existing.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	pod := updated.Spec.Template
+	assert.NotContains(t, pod.Labels, "team", "Pod labels")
+	assert.NotContains(t, pod.Annotations, "example.com/owner", "Pod annotations")
+	assert.Empty(t, pod.Spec.NodeSelector, "node selector")
+	assert.Empty(t, pod.Spec.Tolerations, "tolerations")
+	assert.Nil(t, pod.Spec.Affinity, "affinity")
+	assert.Empty(t, pod.Spec.Containers[0].Resources.Limits, "resources")
+}
+
+func TestCanUpgrade(t *testing.T) {
+	// adapters whose rollout is observed in the cache, and which don't
+	// count towards the limit once they are rolled out
+	a := newTestAdapterDeployment("a", true)
+	b := newTestAdapterDeployment("b", true)
+	c := newTestAdapterDeployment("c", true)
+	// adapter whose rollout isn't complete
+	rolling := newTestAdapterDeployment("rolling", false)
+
+	r := newTestUpgradesReconciler(t, 2, a, b, c, rolling)
+
+	assert.True(t, r.canUpgrade(a.UID), "first upgrade")
+	// the cache still reports the first adapter as rolled out
+	assert.False(t, r.canUpgrade(b.UID), "upgrade beyond the limit")
+	assert.True(t, r.canUpgrade(a.UID), "upgrade already in flight")
+
+	r.upgradeDone(a.UID)
+	assert.True(t, r.canUpgrade(b.UID), "upgrade after a rollout completed")
+	assert.False(t, r.canUpgrade(c.UID), "upgrade beyond the limit")
+	assert.True(t, r.canUpgrade(rolling.UID), "upgrade of an adapter which is rolling out")
+}
+
+func TestCanUpgradeUnlimited(t *testing.T) {
+	a := newTestAdapterDeployment("a", false)
+	b := newTestAdapterDeployment("b", false)
+
+	r := newTestUpgradesReconciler(t, 0, a, b)
+
+	assert.True(t, r.canUpgrade(a.UID))
+	assert.True(t, r.canUpgrade(b.UID))
+	assert.Empty(t, r.upgrades.inFlight, "upgrades in flight")
+}
+
+// newTestUpgradesReconciler returns an adapterReconciler which allows the
+// given number of concurrent upgrades, and whose cache contains the given
+// receive adapter Deployments.
+func newTestUpgradesReconciler(t *testing.T, maxUpgrades int, cached ...*appsv1.Deployment) *adapterReconciler {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, d := range cached {
+		require.NoError(t, indexer.Add(d))
+	}
+
+	return &adapterReconciler{
+		deploymentLister:      appslistersv1.NewDeploymentLister(indexer),
+		maxConcurrentUpgrades: maxUpgrades,
+		upgrades:              newUpgradeSet(),
+	}
+}
+
+// newTestAdapterDeployment returns a receive adapter Deployment, whose rollout
+// is either complete or in progress.
+func newTestAdapterDeployment(name string, rolledOut bool) *appsv1.Deployment {
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       name,
+			UID:        types.UID(name),
+			Labels:     adapterLabels,
+			Generation: 2,
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+		},
+	}
+	if rolledOut {
+		d.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+			AvailableReplicas:  1,
+		}
+	}
+	return d
+}
+
+// testAdapterImage is the image of the receive adapters of tests.
+const testAdapterImage = "registry.example.com/receive-adapter"
+
+// testConfigs is a source.ConfigAccessor without configurations.
+type testConfigs struct {
+	source.ConfigAccessor
+}
+
+func (testConfigs) ToEnvVars() []corev1.EnvVar {
+	return nil
+}
+
+// newTestAdapterReconciler returns an adapterReconciler which generates
+// receive adapters.
+func newTestAdapterReconciler() *adapterReconciler {
+	return &adapterReconciler{
+		receiveAdapterImage: testAdapterImage,
+		defaultMode:         v1beta1.AdapterModeDeployment,
+		configs:             testConfigs{},
+	}
+}
+
+// newTestAdapterArgs returns the attributes of the receive adapter of a
+// source with the given customizations and secret token hash.
+func newTestAdapterArgs(tmpl *v1beta1.AdapterTemplate, secretTokenHash string) *adapterArgs {
+	return &adapterArgs{
+		owner:              newTestGitLabSource(),
+		template:           tmpl,
+		serviceAccountName: "receive-adapter",
+		secretToken:        secretKeyRef("secret-token"),
+		secretTokenHash:    secretTokenHash,
+		sinkURI:            apis.HTTP("sink.example.com"),
+		eventSource:        testProjectURL,
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
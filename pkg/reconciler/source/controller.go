@@ -18,27 +18,140 @@ package gitlab
 
 import (
 	"context"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 
+	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
 	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
+	filteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	ingressinformer "knative.dev/pkg/client/injection/kube/informers/networking/v1/ingress"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
 	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/system"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servinginformersv1 "knative.dev/serving/pkg/client/informers/externalversions/serving/v1"
 	servingclient "knative.dev/serving/pkg/client/injection/client"
-	serviceinformerv1 "knative.dev/serving/pkg/client/injection/informers/serving/v1/service"
+	servinginformerfactory "knative.dev/serving/pkg/client/injection/informers/factory"
 
 	"knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
 	"knative.dev/eventing-gitlab/pkg/client/gitlab"
-	informerv1alpha1 "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1alpha1/gitlabsource"
-	reconcilerv1alpha1 "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1alpha1/gitlabsource"
+	gitlabclient "knative.dev/eventing-gitlab/pkg/client/injection/client"
+	systeminformerv1alpha1 "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1alpha1/gitlabsystemsource"
+	informerv1beta1 "knative.dev/eventing-gitlab/pkg/client/injection/informers/sources/v1beta1/gitlabsource"
+	systemreconcilerv1alpha1 "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1alpha1/gitlabsystemsource"
+	reconcilerv1beta1 "knative.dev/eventing-gitlab/pkg/client/injection/reconciler/sources/v1beta1/gitlabsource"
+	"knative.dev/eventing-gitlab/pkg/reconciler/accesstoken"
+	"knative.dev/eventing-gitlab/pkg/secret"
 )
 
 type envConfig struct {
+	secretBackendConfig
+
 	Image string `envconfig:"GL_RA_IMAGE" required:"true"`
+
+	// Maximum number of receive adapters upgraded to a new image at once.
+	MaxConcurrentUpgrades int `envconfig:"GL_RA_MAX_CONCURRENT_UPGRADES" default:"5"`
+
+	// Identifier of the cluster, part of the marker of the hooks registered
+	// by the controller. Defaults to the UID of the kube-system namespace.
+	ClusterID string `envconfig:"GL_CLUSTER_ID"`
+
+	// Policy and interval of the garbage collection of orphaned hooks.
+	WebhookGCPolicy   string        `envconfig:"GL_WEBHOOK_GC_POLICY" default:"Report"`
+	WebhookGCInterval time.Duration `envconfig:"GL_WEBHOOK_GC_INTERVAL" default:"1h"`
+
+	// Mode of receive adapters whose source doesn't specify one.
+	AdapterMode string `envconfig:"GL_RA_MODE" default:"KnativeService"`
+
+	// Default exposure of receive adapters outside of the cluster. The
+	// Gateway has the format "namespace/name".
+	ExposureType         string `envconfig:"GL_RA_EXPOSURE_TYPE"`
+	ExposureDomain       string `envconfig:"GL_RA_EXPOSURE_DOMAIN"`
+	ExposureGateway      string `envconfig:"GL_RA_EXPOSURE_GATEWAY"`
+	ExposureIngressClass string `envconfig:"GL_RA_EXPOSURE_INGRESS_CLASS"`
+	ExposureTLSSecret    string `envconfig:"GL_RA_EXPOSURE_TLS_SECRET"`
+
+	// Public URL of the shared receive adapter, if it is exposed.
+	SharedAdapterURL string `envconfig:"GL_SHARED_ADAPTER_URL"`
+
+	// Duration during which the previous secret token of a source is
+	// accepted after its hooks were updated with a new token.
+	SecretTokenGracePeriod time.Duration `envconfig:"GL_SECRET_TOKEN_GRACE_PERIOD" default:"10m"`
+
+	// Duration before the expiry of the API token of a source from which
+	// warnings are emitted.
+	TokenExpiryWarningThreshold time.Duration `envconfig:"GL_TOKEN_EXPIRY_WARNING_THRESHOLD" default:"336h"`
+
+	// Provisioning of project access tokens for sources which don't
+	// reference an access token, enabled when the Secret containing the
+	// provisioner's API token is set. This Secret is read from the
+	// controller's namespace.
+	TokenProvisionerURL       string `envconfig:"GL_TOKEN_PROVISIONER_URL"`
+	TokenProvisionerSecret    string `envconfig:"GL_TOKEN_PROVISIONER_SECRET"`
+	TokenProvisionerSecretKey string `envconfig:"GL_TOKEN_PROVISIONER_SECRET_KEY" default:"accessToken"`
+
+	// Lifetime of provisioned access tokens.
+	ProvisionedTokenLifetime time.Duration `envconfig:"GL_PROVISIONED_TOKEN_LIFETIME" default:"720h"`
+}
+
+// Bounds of the lifetime of provisioned access tokens. GitLab sets the
+// expiry of access tokens to a date, and limits their lifetime to a year.
+const (
+	minProvisionedTokenLifetime = 48 * time.Hour
+	maxProvisionedTokenLifetime = 365 * 24 * time.Hour
+)
+
+// Backends of the secrets referenced by event sources.
+const (
+	secretBackendKubernetes = "Kubernetes"
+	secretBackendFile       = "File"
+)
+
+// secretBackendConfig is the configuration of the backend which the secrets
+// referenced by event sources are read from.
+type secretBackendConfig struct {
+	// Backend which secrets are read from: Kubernetes (default) or File.
+	SecretBackend string `envconfig:"GL_SECRET_BACKEND" default:"Kubernetes"`
+	// Directory containing the secrets, with the File backend.
+	SecretDir string `envconfig:"GL_SECRET_DIR" default:"/var/run/secrets/gitlab"`
+}
+
+// WithSecretInformer returns a context which configures the informer of the
+// Secrets referenced by event sources. This informer is disabled when secrets
+// are read from files, so that the controller doesn't need to read Secrets.
+func WithSecretInformer(ctx context.Context) context.Context {
+	env := &secretBackendConfig{}
+	envconfig.MustProcess("", env)
+
+	switch env.SecretBackend {
+	case secretBackendKubernetes:
+		// only the Secrets referenced by event sources are cached
+		return filteredfactory.WithSelectors(ctx, secret.WatchLabelSelector)
+	case secretBackendFile:
+		return filteredfactory.WithSelectors(ctx)
+	default:
+		logging.FromContext(ctx).Fatalf("Unknown secret backend %q", env.SecretBackend)
+		return nil
+	}
 }
 
 // NewController returns the controller implementation with reconciler structure and logger
@@ -49,27 +162,392 @@ func NewController(
 	env := &envConfig{}
 	envconfig.MustProcess("", env)
 
-	serviceInformer := serviceinformerv1.Get(ctx)
+	ar, adapterInformers := newAdapterReconciler(ctx, cmw, env)
 
 	r := &Reconciler{
-		gitlabCg:            gitlab.NewWebhookClientGetter(kubeclient.Get(ctx).CoreV1().Secrets),
-		ksvcCli:             servingclient.Get(ctx).ServingV1().Services,
-		ksvcLister:          serviceInformer.Lister(),
-		receiveAdapterImage: env.Image,
-		loggingContext:      ctx,
-		configs:             source.WatchConfigurations(ctx, "gitlab-controller", cmw),
+		adapterReconciler: ar,
+		secretTokenReconciler: secretTokenReconciler{
+			secretTracker:       newSecretTracker(ctx, env),
+			rotationGracePeriod: env.SecretTokenGracePeriod,
+			hasher:              newSecretTokenHasher(ctx, env),
+		},
+		tokenExpiry:    accesstoken.NewMonitor("GitLabSource", env.TokenExpiryWarningThreshold),
+		loggingContext: ctx,
+	}
+	r.gitlabCg = gitlab.NewWebhookClientGetter(r.secretGetter, r.hasher)
+	r.clusterID = clusterID(ctx, env)
+	r.credentials = newCredentialsCache(credentialsTTL)
+	r.accessTokenReconciler = newAccessTokenReconciler(ctx, env, &r.secretTracker)
+
+	sourceInformer := informerv1beta1.Get(ctx)
+
+	switch gcPolicy := webhookGCPolicy(env.WebhookGCPolicy); gcPolicy {
+	case webhookGCPolicyDelete, webhookGCPolicyReport, webhookGCPolicyDisabled:
+	default:
+		logging.FromContext(ctx).Fatalf("Unknown webhook garbage collection policy %q", gcPolicy)
+	}
+
+	sweeper := &webhookSweeper{
+		policy:    webhookGCPolicy(env.WebhookGCPolicy),
+		interval:  env.WebhookGCInterval,
+		lister:    sourceInformer.Lister(),
+		hasSynced: sourceInformer.Informer().HasSynced,
+		sourceCli: gitlabclient.Get(ctx).SourcesV1beta1().GitLabSources,
+		cg:        r.gitlabCg,
+		clusterID: r.clusterID,
+		recorder:  newEventRecorder(ctx, "gitlab-webhook-gc"),
 	}
 
-	impl := reconcilerv1alpha1.NewImpl(ctx, r)
+	impl := reconcilerv1beta1.NewImpl(ctx, r, func(*controller.Impl) controller.Options {
+		return controller.Options{
+			PromoteFunc: func(bkt reconciler.Bucket) {
+				sweeper.promote(ctx, bkt)
+			},
+			DemoteFunc: sweeper.demote,
+		}
+	})
 	r.sinkResolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	r.tracker = impl.Tracker
+
+	sourceInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	resyncOnConfigChange(cmw, impl, sourceInformer.Informer())
+
+	if r.provisioner != nil {
+		r.allowList.watch(cmw, func() {
+			impl.GlobalResync(sourceInformer.Informer())
+		})
+	}
+
+	for _, inf := range adapterInformers {
+		inf.AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1beta1.GitLabSource{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		})
+	}
 
-	informerv1alpha1.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	trackSecretChanges(ctx, impl, env)
 
-	serviceInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
-		FilterFunc: controller.FilterController(&v1alpha1.GitLabSource{}),
-		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	// sources which use the shared receive adapter follow its availability
+	deploymentinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(system.Namespace(), sharedAdapterName),
+		Handler: controller.HandleAll(func(interface{}) {
+			impl.GlobalResync(sourceInformer.Informer())
+		}),
 	})
 
 	return impl
 
 }
+
+// NewSystemSourceController returns the controller implementation for GitLabSystemSource objects.
+func NewSystemSourceController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+	env := &envConfig{}
+	envconfig.MustProcess("", env)
+
+	ar, adapterInformers := newAdapterReconciler(ctx, cmw, env)
+
+	// the shared receive adapter only serves GitLabSources
+	if ar.defaultMode == v1beta1.AdapterModeShared {
+		ar.defaultMode = v1beta1.AdapterModeKnativeService
+		if ar.ksvcCli == nil {
+			ar.defaultMode = v1beta1.AdapterModeDeployment
+		}
+	}
+
+	r := &SystemSourceReconciler{
+		adapterReconciler: ar,
+		secretTracker:     newSecretTracker(ctx, env),
+	}
+	r.gitlabCg = gitlab.NewSystemHookClientGetter(r.secretGetter)
+
+	impl := systemreconcilerv1alpha1.NewImpl(ctx, r)
+	r.sinkResolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	r.tracker = impl.Tracker
+
+	systemSourceInformer := systeminformerv1alpha1.Get(ctx)
+
+	systemSourceInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	resyncOnConfigChange(cmw, impl, systemSourceInformer.Informer())
+	trackSecretChanges(ctx, impl, env)
+
+	for _, inf := range adapterInformers {
+		inf.AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1alpha1.GitLabSystemSource{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		})
+	}
+
+	return impl
+}
+
+// clusterID returns the identifier of the cluster set in the environment, or
+// otherwise the UID of the kube-system namespace, which is stable for the
+// lifetime of the cluster.
+func clusterID(ctx context.Context, env *envConfig) string {
+	if env.ClusterID != "" {
+		return env.ClusterID
+	}
+
+	ns, err := kubeclient.Get(ctx).CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		logging.FromContext(ctx).Fatalw("Failed to determine the identifier of the cluster, "+
+			"which can be set in GL_CLUSTER_ID", zap.Error(err))
+	}
+	return string(ns.UID)
+}
+
+// newSecretTokenHasher returns a SecretTokenHasher keyed by the key stored in
+// the controller's namespace. With the Kubernetes backend, the key is
+// generated on the first start of the controller, while it must be provided
+// with the File backend.
+func newSecretTokenHasher(ctx context.Context, env *envConfig) *gitlab.SecretTokenHasher {
+	logger := logging.FromContext(ctx)
+
+	if env.SecretBackend == secretBackendFile {
+		keys, err := secret.NewFileGetter(env.SecretDir, system.Namespace()).Get(secretTokenHashKeyRef)
+		if err != nil {
+			logger.Fatalw("Failed to read the key of the hashes of secret tokens", zap.Error(err))
+		}
+		return gitlab.NewSecretTokenHasher([]byte(keys[0]))
+	}
+
+	key, err := ensureSecretTokenHashKey(ctx, kubeclient.Get(ctx).CoreV1().Secrets(system.Namespace()))
+	if err != nil {
+		logger.Fatalw("Failed to obtain the key of the hashes of secret tokens", zap.Error(err))
+	}
+	return gitlab.NewSecretTokenHasher(key)
+}
+
+// newAccessTokenReconciler returns an accessTokenReconciler which reads and
+// writes the Secrets of sources through the given secretTracker. Access
+// tokens are only provisioned when the provisioner's Secret is configured.
+func newAccessTokenReconciler(ctx context.Context, env *envConfig, st *secretTracker) accessTokenReconciler {
+	r := accessTokenReconciler{
+		secrets:  st,
+		lifetime: env.ProvisionedTokenLifetime,
+	}
+
+	if env.TokenProvisionerSecret == "" {
+		return r
+	}
+
+	if u, err := apis.ParseURL(env.TokenProvisionerURL); err != nil || u == nil || u.Host == "" {
+		logging.FromContext(ctx).Fatalf("Invalid URL of the GitLab instance which access tokens are provisioned on: %q",
+			env.TokenProvisionerURL)
+	}
+	if env.ProvisionedTokenLifetime < minProvisionedTokenLifetime ||
+		env.ProvisionedTokenLifetime > maxProvisionedTokenLifetime {
+		logging.FromContext(ctx).Fatalf("Lifetime of provisioned access tokens must be between %s and %s, got %s",
+			minProvisionedTokenLifetime, maxProvisionedTokenLifetime, env.ProvisionedTokenLifetime)
+	}
+
+	r.allowList = newProvisioningAllowList(env.TokenProvisionerURL)
+	r.provisioner = gitlab.NewAccessTokenProvisioner(
+		secret.NewGetter(kubeclient.Get(ctx).CoreV1().Secrets(system.Namespace())),
+		env.TokenProvisionerURL,
+		&corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: env.TokenProvisionerSecret},
+			Key:                  env.TokenProvisionerSecretKey,
+		},
+	)
+
+	return r
+}
+
+// newSecretTracker returns a secretTracker for the configured secret backend.
+// With the Kubernetes backend, it is backed by the informer of the Secrets
+// referenced by event sources, and its tracker is set once the controller is
+// created.
+func newSecretTracker(ctx context.Context, env *envConfig) secretTracker {
+	if env.SecretBackend == secretBackendFile {
+		return secretTracker{
+			secretCli: kubeclient.Get(ctx).CoreV1().Secrets,
+			secretDir: env.SecretDir,
+		}
+	}
+
+	return secretTracker{
+		secretCli:    kubeclient.Get(ctx).CoreV1().Secrets,
+		secretLister: filteredsecretinformer.Get(ctx, secret.WatchLabelSelector).Lister(),
+	}
+}
+
+// trackSecretChanges enqueues the sources which reference a Secret whenever
+// this Secret changes. Changes to files aren't tracked.
+func trackSecretChanges(ctx context.Context, impl *controller.Impl, env *envConfig) {
+	if env.SecretBackend == secretBackendFile {
+		return
+	}
+
+	filteredsecretinformer.Get(ctx, secret.WatchLabelSelector).Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(impl.Tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("Secret")),
+	))
+}
+
+// newAdapterReconciler returns an adapterReconciler configured from the given
+// environment, together with the informers of the workloads which run
+// receive adapters.
+//
+// Knative Services are only watched in clusters where Knative Serving is
+// installed, since the cache of their informer would otherwise never sync.
+func newAdapterReconciler(ctx context.Context, cmw configmap.Watcher,
+	env *envConfig) (adapterReconciler, []cache.SharedIndexInformer) {
+
+	logger := logging.FromContext(ctx)
+
+	switch mode := v1beta1.AdapterMode(env.AdapterMode); mode {
+	case v1beta1.AdapterModeKnativeService, v1beta1.AdapterModeDeployment, v1beta1.AdapterModeShared:
+	default:
+		logger.Fatalf("Unknown receive adapter mode %q", mode)
+	}
+
+	deploymentInformer := deploymentinformer.Get(ctx)
+
+	r := adapterReconciler{
+		deploymentCli:         kubeclient.Get(ctx).AppsV1().Deployments,
+		deploymentLister:      deploymentInformer.Lister(),
+		serviceCli:            kubeclient.Get(ctx).CoreV1().Services,
+		receiveAdapterImage:   env.Image,
+		defaultMode:           v1beta1.AdapterMode(env.AdapterMode),
+		maxConcurrentUpgrades: env.MaxConcurrentUpgrades,
+		upgrades:              adapterUpgrades,
+		configs:               source.WatchConfigurations(ctx, "gitlab-controller", cmw),
+	}
+
+	informers := []cache.SharedIndexInformer{deploymentInformer.Informer()}
+
+	if serviceInformer := watchKnativeServices(ctx); serviceInformer != nil {
+		r.ksvcCli = servingclient.Get(ctx).ServingV1().Services
+		r.ksvcLister = serviceInformer.Lister()
+		informers = append(informers, serviceInformer.Informer())
+	} else if r.defaultMode == v1beta1.AdapterModeKnativeService {
+		logger.Warnf("Knative Serving is not installed, sources require the %q adapter mode",
+			v1beta1.AdapterModeDeployment)
+	}
+
+	ingressInformer := ingressinformer.Get(ctx)
+	r.ingressCli = kubeclient.Get(ctx).NetworkingV1().Ingresses
+	r.ingressLister = ingressInformer.Lister()
+	informers = append(informers, ingressInformer.Informer())
+
+	if routeInformer := watchHTTPRoutes(ctx); routeInformer != nil {
+		r.routeCli = dynamicclient.Get(ctx).Resource(httpRouteResource)
+		r.routeLister = routeInformer.Lister()
+		informers = append(informers, routeInformer.Informer())
+	}
+
+	r.exposureDomain = env.ExposureDomain
+	r.defaultExposure = &v1beta1.AdapterExposure{
+		Type:             v1beta1.ExposureType(env.ExposureType),
+		IngressClassName: env.ExposureIngressClass,
+		TLSSecretName:    env.ExposureTLSSecret,
+	}
+	if env.ExposureGateway != "" {
+		ns, name, err := cache.SplitMetaNamespaceKey(env.ExposureGateway)
+		if err != nil {
+			logger.Fatalw("Invalid default Gateway of receive adapters", zap.Error(err))
+		}
+		r.defaultExposure.Gateway = &v1beta1.GatewayReference{Namespace: ns, Name: name}
+	}
+
+	switch r.defaultExposure.Type {
+	case "", v1beta1.ExposureTypeHTTPRoute, v1beta1.ExposureTypeIngress:
+	default:
+		logger.Fatalf("Unknown receive adapter exposure type %q", r.defaultExposure.Type)
+	}
+
+	if env.SharedAdapterURL != "" {
+		u, err := apis.ParseURL(env.SharedAdapterURL)
+		if err != nil {
+			logger.Fatalw("Invalid URL of the shared receive adapter", zap.Error(err))
+		}
+		r.sharedAdapterURL = u
+	}
+
+	return r, informers
+}
+
+// watchKnativeServices returns a started informer for the Knative Services
+// of receive adapters, or nil if Knative Serving isn't installed.
+//
+// The informer isn't registered for injection, so that it doesn't block the
+// startup of the controller when Knative Serving is missing. It is therefore
+// started here, before the controller's informers.
+func watchKnativeServices(ctx context.Context) servinginformersv1.ServiceInformer {
+	if !isAPIInstalled(ctx, servingv1.SchemeGroupVersion.String()) {
+		return nil
+	}
+
+	factory := servinginformerfactory.Get(ctx)
+	serviceInformer := factory.Serving().V1().Services()
+	serviceInformer.Informer()
+
+	factory.Start(ctx.Done())
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			logging.FromContext(ctx).Fatalf("Failed to sync the informer cache of %v", typ)
+		}
+	}
+
+	return serviceInformer
+}
+
+// watchHTTPRoutes returns a started informer for the HTTPRoutes which expose
+// receive adapters, or nil if the Gateway API isn't installed.
+func watchHTTPRoutes(ctx context.Context) informers.GenericInformer {
+	if !isAPIInstalled(ctx, httpRouteResource.GroupVersion().String()) {
+		return nil
+	}
+
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicclient.Get(ctx),
+		controller.GetResyncPeriod(ctx), metav1.NamespaceAll, func(opts *metav1.ListOptions) {
+			opts.LabelSelector = labels.SelectorFromSet(adapterLabels).String()
+		})
+	routeInformer := factory.ForResource(httpRouteResource)
+	routeInformer.Informer()
+
+	factory.Start(ctx.Done())
+	for res, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			logging.FromContext(ctx).Fatalf("Failed to sync the informer cache of %v", res)
+		}
+	}
+
+	return routeInformer
+}
+
+// isAPIInstalled returns whether the given API group version is served by the
+// cluster.
+func isAPIInstalled(ctx context.Context, groupVersion string) bool {
+	_, err := kubeclient.Get(ctx).Discovery().ServerResourcesForGroupVersion(groupVersion)
+	switch {
+	case apierrors.IsNotFound(err):
+		return false
+	case err != nil:
+		logging.FromContext(ctx).Fatalw("Failed to discover the "+groupVersion+" API", zap.Error(err))
+	}
+	return true
+}
+
+// resyncOnConfigChange enqueues all objects from the given informer whenever
+// the logging or observability configuration changes, so that it propagates
+// to existing receive adapters.
+func resyncOnConfigChange(cmw configmap.Watcher, impl *controller.Impl, si cache.SharedInformer) {
+	resync := func(*corev1.ConfigMap) {
+		impl.GlobalResync(si)
+	}
+
+	for _, name := range []string{logging.ConfigMapName(), o11yconfigmap.Name()} {
+		if dcmw, ok := cmw.(configmap.DefaultingWatcher); ok {
+			dcmw.WatchWithDefault(corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Data:       map[string]string{},
+			}, resync)
+		} else {
+			cmw.Watch(name, resync)
+		}
+	}
+}
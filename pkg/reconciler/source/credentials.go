@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/logging"
+
+	gogitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+)
+
+// Reasons of the CredentialsValid condition of sources whose API token can't
+// manage their hooks.
+const (
+	reasonTokenRevoked            = "TokenRevoked"
+	reasonTokenExpired            = "TokenExpired"
+	reasonInsufficientScope       = "InsufficientScope"
+	reasonInsufficientProjectRole = "InsufficientProjectRole"
+	reasonInsufficientGroupRole   = "InsufficientGroupRole"
+)
+
+// errCredentialsUnverified indicates that the GitLab API token of a source
+// couldn't be verified, e.g. because GitLab is unavailable.
+var errCredentialsUnverified = errors.New("verifying GitLab API token")
+
+// credentialsError indicates that the GitLab API token of a source is not
+// allowed to manage the hooks of one of its projects or group.
+type credentialsError struct {
+	reason  string
+	message string
+}
+
+// Error implements error.
+func (e *credentialsError) Error() string {
+	return e.message
+}
+
+// verifyCredentials verifies that the API token used by the given client is
+// allowed to manage the hooks of the given hook's project or group: the token
+// must be active, have the "api" scope, and its user must have the required
+// role on the project or group.
+// A *credentialsError is returned when the token isn't allowed to, together
+// with the attributes of the token when GitLab reported them. Since GitLab
+// doesn't report anything about expired tokens, the given expiry time,
+// observed previously, tells expired tokens apart from revoked ones.
+func verifyCredentials(ctx context.Context, cli gitlab.WebhookClient,
+	hook *v1beta1.WebhookStatus, knownExpiry *metav1.Time) (*gitlab.Credentials, error) {
+
+	creds, err := cli.Credentials()
+	switch {
+	case hasStatusCode(err, http.StatusUnauthorized):
+		if isPast(knownExpiry) {
+			return nil, &credentialsError{
+				reason:  reasonTokenExpired,
+				message: "the GitLab API token expired on " + knownExpiry.UTC().Format(time.RFC3339),
+			}
+		}
+		return nil, &credentialsError{
+			reason:  reasonTokenRevoked,
+			message: "the GitLab API token is invalid, expired or revoked",
+		}
+
+	case hasStatusCode(err, http.StatusForbidden):
+		// GitLab denies the information about the token to tokens which
+		// have none of the API scopes
+		return nil, &credentialsError{
+			reason:  reasonInsufficientScope,
+			message: fmt.Sprintf("the GitLab API token lacks the %q scope", gitlab.APIScope),
+		}
+
+	case err != nil:
+		return nil, fmt.Errorf("%w: %w", errCredentialsUnverified, err)
+	}
+
+	logging.FromContext(ctx).Debugw("Verified GitLab API token",
+		zap.String("target", hook.Target()),
+		zap.String("user", creds.Username),
+		zap.Strings("scopes", creds.Scopes),
+		zap.Int("accessLevel", int(creds.AccessLevel)))
+
+	switch {
+	case !creds.Active && creds.ExpiresAt != nil && !creds.ExpiresAt.After(time.Now()):
+		return creds, &credentialsError{
+			reason: reasonTokenExpired,
+			message: fmt.Sprintf("the GitLab API token of user %q expired on %s",
+				creds.Username, creds.ExpiresAt.UTC().Format(time.RFC3339)),
+		}
+
+	case !creds.Active:
+		return creds, &credentialsError{
+			reason:  reasonTokenRevoked,
+			message: fmt.Sprintf("the GitLab API token of user %q is revoked", creds.Username),
+		}
+
+	case !creds.HasScope(gitlab.APIScope):
+		return creds, &credentialsError{
+			reason: reasonInsufficientScope,
+			message: fmt.Sprintf("the GitLab API token of user %q lacks the %q scope",
+				creds.Username, gitlab.APIScope),
+		}
+
+	case !creds.CanManageHooks():
+		reason := reasonInsufficientProjectRole
+		if hook.GroupURL != "" {
+			reason = reasonInsufficientGroupRole
+		}
+
+		role := "no role"
+		if creds.AccessLevel > gogitlab.NoPermissions {
+			role = "the " + accessLevelName(creds.AccessLevel) + " role"
+		}
+
+		return creds, &credentialsError{
+			reason: reason,
+			message: fmt.Sprintf("user %q has %s on %s, managing webhooks requires the %s role",
+				creds.Username, role, hook.Target(), accessLevelName(creds.RequiredAccessLevel)),
+		}
+	}
+
+	return creds, nil
+}
+
+// credentialsTTL is the duration for which successful verifications of API
+// tokens are cached.
+const credentialsTTL = 10 * time.Minute
+
+// credentialsCache caches the successful verifications of the API tokens of
+// sources, so that tokens are verified once for all hooks and all
+// reconciliations of a source, instead of once per hook per reconciliation.
+type credentialsCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[credentialsKey]credentialsEntry
+}
+
+// credentialsKey identifies the verification of an API token for a project
+// or group. Tokens are identified by their hash.
+type credentialsKey struct {
+	tokenHash string
+	target    string
+}
+
+// credentialsEntry is the cached verification of an API token.
+type credentialsEntry struct {
+	creds      *gitlab.Credentials
+	verifiedAt time.Time
+}
+
+func newCredentialsCache(ttl time.Duration) *credentialsCache {
+	return &credentialsCache{
+		ttl:     ttl,
+		entries: make(map[credentialsKey]credentialsEntry),
+	}
+}
+
+// get returns the attributes of the API token with the given key, if they
+// were verified within the TTL of the cache.
+func (c *credentialsCache) get(key credentialsKey) *gitlab.Credentials {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Since(e.verifiedAt) > c.ttl {
+		return nil
+	}
+	return e.creds
+}
+
+// set records the successful verification of the API token with the given
+// key, and prunes expired verifications.
+func (c *credentialsCache) set(key credentialsKey, creds *gitlab.Credentials) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.Sub(e.verifiedAt) > c.ttl {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = credentialsEntry{
+		creds:      creds,
+		verifiedAt: now,
+	}
+}
+
+// forget removes the verification of the API token with the given key.
+func (c *credentialsCache) forget(key credentialsKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// isPast returns whether the given time is set and in the past.
+func isPast(t *metav1.Time) bool {
+	return t != nil && !t.After(time.Now())
+}
+
+// accessLevelName returns the name of the GitLab role with the given access
+// level.
+func accessLevelName(level gogitlab.AccessLevelValue) string {
+	switch {
+	case level >= gogitlab.OwnerPermissions:
+		return "Owner"
+	case level >= gogitlab.MaintainerPermissions:
+		return "Maintainer"
+	case level >= gogitlab.DeveloperPermissions:
+		return "Developer"
+	case level >= gogitlab.ReporterPermissions:
+		return "Reporter"
+	case level >= gogitlab.GuestPermissions:
+		return "Guest"
+	default:
+		return "Minimal Access"
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gogitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+)
+
+func TestVerifyCredentials(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name string
+		// Error of the verification of the token by GitLab.
+		failure error
+		// Changes to the attributes of the token reported by GitLab.
+		change func(*gitlab.Credentials)
+		// Expiry of the token observed previously.
+		knownExpiry *metav1.Time
+		// Whether the hook belongs to a group.
+		group bool
+
+		expectReason string
+		expectErr    error
+	}{
+		{
+			name: "Token can manage hooks",
+		},
+		{
+			name:         "Token is rejected",
+			failure:      newGitLabError(http.StatusUnauthorized),
+			expectReason: reasonTokenRevoked,
+		},
+		{
+			name:         "Token is rejected after its known expiry",
+			failure:      newGitLabError(http.StatusUnauthorized),
+			knownExpiry:  &metav1.Time{Time: past},
+			expectReason: reasonTokenExpired,
+		},
+		{
+			name:         "Token is rejected before its known expiry",
+			failure:      newGitLabError(http.StatusUnauthorized),
+			knownExpiry:  &metav1.Time{Time: time.Now().Add(time.Hour)},
+			expectReason: reasonTokenRevoked,
+		},
+		{
+			name:         "Token has none of the API scopes",
+			failure:      newGitLabError(http.StatusForbidden),
+			expectReason: reasonInsufficientScope,
+		},
+		{
+			name:      "GitLab is unavailable",
+			failure:   newGitLabError(http.StatusBadGateway),
+			expectErr: errCredentialsUnverified,
+		},
+		{
+			name: "Token expired",
+			change: func(c *gitlab.Credentials) {
+				c.Active = false
+				c.ExpiresAt = &past
+			},
+			expectReason: reasonTokenExpired,
+		},
+		{
+			name: "Token was revoked",
+			change: func(c *gitlab.Credentials) {
+				c.Active = false
+			},
+			expectReason: reasonTokenRevoked,
+		},
+		{
+			name: "Token lacks the api scope",
+			change: func(c *gitlab.Credentials) {
+				c.Scopes = []string{"read_api"}
+			},
+			expectReason: reasonInsufficientScope,
+		},
+		{
+			name: "User lacks the role on the project",
+			change: func(c *gitlab.Credentials) {
+				c.AccessLevel = gogitlab.DeveloperPermissions
+			},
+			expectReason: reasonInsufficientProjectRole,
+		},
+		{
+			name: "User lacks the role on the group",
+			change: func(c *gitlab.Credentials) {
+				c.AccessLevel = gogitlab.NoPermissions
+				c.RequiredAccessLevel = gogitlab.OwnerPermissions
+			},
+			group:        true,
+			expectReason: reasonInsufficientGroupRole,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gl := newFakeGitLab()
+			if tc.failure != nil {
+				gl.failures["Credentials"] = tc.failure
+			}
+			if tc.change != nil {
+				tc.change(gl.creds)
+			}
+
+			hook := &v1beta1.WebhookStatus{ProjectURL: testProjectURL}
+			if tc.group {
+				hook = &v1beta1.WebhookStatus{GroupURL: "https://gitlab.example.com/team-a"}
+			}
+
+			cli := &fakeWebhookClient{gl: gl, target: hook.Target()}
+
+			creds, err := verifyCredentials(context.Background(), cli, hook, tc.knownExpiry)
+
+			switch {
+			case tc.expectErr != nil:
+				assert.ErrorIs(t, err, tc.expectErr)
+				assert.Nil(t, creds)
+
+			case tc.expectReason != "":
+				var credsErr *credentialsError
+				require.ErrorAs(t, err, &credsErr)
+				assert.Equal(t, tc.expectReason, credsErr.reason)
+				// the attributes of the token are returned whenever
+				// GitLab reported them
+				assert.Equal(t, tc.failure == nil, creds != nil, "credentials returned")
+
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, gl.creds, creds)
+			}
+		})
+	}
+}
+
+func TestCredentialsCache(t *testing.T) {
+	const ttl = time.Minute
+
+	key := credentialsKey{tokenHash: "hash", target: testProjectURL}
+	creds := &gitlab.Credentials{Username: "bot"}
+
+	c := newCredentialsCache(ttl)
+	assert.Nil(t, c.get(key), "verification before caching")
+
+	c.set(key, creds)
+	assert.Same(t, creds, c.get(key), "cached verification")
+	assert.Nil(t, c.get(credentialsKey{tokenHash: "other-hash", target: testProjectURL}), "verification of another token")
+
+	c.forget(key)
+	assert.Nil(t, c.get(key), "forgotten verification")
+
+	// verifications older than the TTL are ignored, and pruned once
+	// another verification is cached
+	c.set(key, creds)
+	c.entries[key] = credentialsEntry{creds: creds, verifiedAt: time.Now().Add(-2 * ttl)}
+	assert.Nil(t, c.get(key), "expired verification")
+
+	otherKey := credentialsKey{tokenHash: "hash", target: "https://gitlab.example.com/other"}
+	c.set(otherKey, creds)
+	assert.Len(t, c.entries, 1)
+	assert.Same(t, creds, c.get(otherKey))
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+
+	gogitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+)
+
+// testSecretToken is the secret token applied to hooks by the fake webhook
+// clients, and testSecretTokenHasher the hasher of its hashes.
+const testSecretToken = "secret-token"
+
+var testSecretTokenHasher = gitlab.NewSecretTokenHasher([]byte("key"))
+
+// fakeGitLab is an in-memory GitLab instance, whose projects and groups are
+// identified by their URL.
+type fakeGitLab struct {
+	// Hooks of each project or group.
+	hooks map[string]map[int]*gitlab.Hook
+	// Credentials of the API token of all sources.
+	creds *gitlab.Credentials
+
+	// Errors returned by each method of the webhook clients.
+	failures map[string]error
+
+	lastHookID int
+	// Number of calls to each method of the webhook clients.
+	calls map[string]int
+}
+
+func newFakeGitLab() *fakeGitLab {
+	return &fakeGitLab{
+		hooks: make(map[string]map[int]*gitlab.Hook),
+		creds: &gitlab.Credentials{
+			Username:            "bot",
+			Active:              true,
+			Scopes:              []string{gitlab.APIScope},
+			AccessLevel:         gogitlab.MaintainerPermissions,
+			RequiredAccessLevel: gogitlab.MaintainerPermissions,
+		},
+		failures: make(map[string]error),
+		calls:    make(map[string]int),
+	}
+}
+
+// addHook registers the given hook with the given project or group, and
+// returns its ID.
+func (g *fakeGitLab) addHook(target string, h *gitlab.Hook) int {
+	g.lastHookID++
+
+	h = copyHook(h)
+	h.ID = g.lastHookID
+	if g.hooks[target] == nil {
+		g.hooks[target] = make(map[int]*gitlab.Hook)
+	}
+	g.hooks[target][h.ID] = h
+
+	return h.ID
+}
+
+// getter returns a WebhookClientGetter for the fake instance.
+func (g *fakeGitLab) getter() gitlab.WebhookClientGetter {
+	return gitlab.WebhookClientGetterFunc(func(_ *v1beta1.GitLabSource,
+		hook *v1beta1.WebhookStatus) (gitlab.WebhookClient, error) {
+
+		return &fakeWebhookClient{gl: g, target: hook.Target()}, nil
+	})
+}
+
+// fakeWebhookClient is a gitlab.WebhookClient for a project or group of a
+// fakeGitLab.
+type fakeWebhookClient struct {
+	gl     *fakeGitLab
+	target string
+}
+
+var _ gitlab.WebhookClient = (*fakeWebhookClient)(nil)
+
+func (c *fakeWebhookClient) Get(hookID int) (*gitlab.Hook, error) {
+	c.gl.calls["Get"]++
+	if err := c.gl.failures["Get"]; err != nil {
+		return nil, err
+	}
+
+	h, exists := c.gl.hooks[c.target][hookID]
+	if !exists {
+		return nil, newGitLabError(http.StatusNotFound)
+	}
+	return copyHook(h), nil
+}
+
+func (c *fakeWebhookClient) List() ([]*gitlab.Hook, error) {
+	c.gl.calls["List"]++
+	if err := c.gl.failures["List"]; err != nil {
+		return nil, err
+	}
+
+	hooks := make([]*gitlab.Hook, 0, len(c.gl.hooks[c.target]))
+	for _, h := range c.gl.hooks[c.target] {
+		hooks = append(hooks, copyHook(h))
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].ID < hooks[j].ID })
+	return hooks, nil
+}
+
+func (c *fakeWebhookClient) Add(hook *gitlab.Hook) (*gitlab.Hook, error) {
+	c.gl.calls["Add"]++
+	if err := c.gl.failures["Add"]; err != nil {
+		return nil, err
+	}
+
+	id := c.gl.addHook(c.target, hook)
+	return copyHook(c.gl.hooks[c.target][id]), nil
+}
+
+func (c *fakeWebhookClient) Edit(hook *gitlab.Hook) (*gitlab.Hook, error) {
+	c.gl.calls["Edit"]++
+	if err := c.gl.failures["Edit"]; err != nil {
+		return nil, err
+	}
+
+	if _, exists := c.gl.hooks[c.target][hook.ID]; !exists {
+		return nil, newGitLabError(http.StatusNotFound)
+	}
+	c.gl.hooks[c.target][hook.ID] = copyHook(hook)
+	return copyHook(hook), nil
+}
+
+func (c *fakeWebhookClient) Delete(hookID int) error {
+	c.gl.calls["Delete"]++
+	if err := c.gl.failures["Delete"]; err != nil {
+		return err
+	}
+
+	if _, exists := c.gl.hooks[c.target][hookID]; !exists {
+		return newGitLabError(http.StatusNotFound)
+	}
+	delete(c.gl.hooks[c.target], hookID)
+	return nil
+}
+
+func (c *fakeWebhookClient) Owner() (*gitlab.Owner, error) {
+	c.gl.calls["Owner"]++
+	if err := c.gl.failures["Owner"]; err != nil {
+		return nil, err
+	}
+
+	return &gitlab.Owner{ID: 1, FullPath: c.target}, nil
+}
+
+func (c *fakeWebhookClient) SecretTokenHash() string {
+	return testSecretTokenHasher.Hash(testSecretToken)
+}
+
+func (c *fakeWebhookClient) AccessTokenHash() string {
+	return testSecretTokenHasher.Hash("api-token")
+}
+
+func (c *fakeWebhookClient) Credentials() (*gitlab.Credentials, error) {
+	c.gl.calls["Credentials"]++
+	if err := c.gl.failures["Credentials"]; err != nil {
+		return nil, err
+	}
+
+	creds := *c.gl.creds
+	return &creds, nil
+}
+
+// copyHook returns a copy of the given hook, so that hooks of the fake
+// instance are never modified by the code under test.
+func copyHook(h *gitlab.Hook) *gitlab.Hook {
+	cpy := *h
+	cpy.EventTypes = append([]string(nil), h.EventTypes...)
+	return &cpy
+}
+
+// newGitLabError returns an error of the GitLab API with the given status.
+func newGitLabError(code int) error {
+	return &gogitlab.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: code,
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Scheme: "https", Host: "gitlab.example.com"}},
+		},
+	}
+}
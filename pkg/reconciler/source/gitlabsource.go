@@ -21,49 +21,67 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
 
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 
-	"knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/controller"
-	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
 	"knative.dev/pkg/reconciler"
 	"knative.dev/pkg/resolver"
-	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
-	servingclientv1 "knative.dev/serving/pkg/client/clientset/versioned/typed/serving/v1"
-	servinglisters "knative.dev/serving/pkg/client/listers/serving/v1"
 
 	gogitlab "gitlab.com/gitlab-org/api/client-go"
 
-	"knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
 	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+	"knative.dev/eventing-gitlab/pkg/reconciler/accesstoken"
+	"knative.dev/eventing-gitlab/pkg/secret"
 )
 
 // Reconciler reconciles a GitLabSource object
 type Reconciler struct {
-	gitlabCg gitlab.WebhookClientGetter
+	adapterReconciler
+	secretTokenReconciler
+	accessTokenReconciler
+	webhookReconciler
 
-	ksvcCli    func(namespace string) servingclientv1.ServiceInterface
-	ksvcLister servinglisters.ServiceLister
-
-	receiveAdapterImage string
+	// Monitor of the expiry of the sources' API tokens.
+	tokenExpiry *accesstoken.Monitor
 
 	sinkResolver *resolver.URIResolver
 
 	loggingContext context.Context
+}
+
+// webhookReconciler reconciles the hooks of GitLab event sources.
+type webhookReconciler struct {
+	gitlabCg gitlab.WebhookClientGetter
+
+	// Identifier of the cluster, part of the marker of the hooks registered
+	// by the controller, so that hooks registered from other clusters are
+	// never mistaken for orphans.
+	clusterID string
 
-	configs source.ConfigAccessor
+	// Successful verifications of the sources' API tokens.
+	credentials *credentialsCache
 }
 
-func (r *Reconciler) ReconcileKind(ctx context.Context, src *v1alpha1.GitLabSource) reconciler.Event {
-	src.Status.CloudEventAttributes = CreateCloudEventAttributes(src.AsEventSource(), src.EventTypes())
+func (r *Reconciler) ReconcileKind(ctx context.Context, src *v1beta1.GitLabSource) reconciler.Event {
+	src.Status.CloudEventAttributes = nil
+	for _, target := range src.WebhookTargets() {
+		src.Status.CloudEventAttributes = append(src.Status.CloudEventAttributes,
+			CreateCloudEventAttributes(target, src.EventTypes())...)
+	}
 
-	sinkURI, err := resolveSinkURL(ctx, r.sinkResolver, src)
+	sinkURI, err := resolveSinkURL(ctx, r.sinkResolver, src, &src.Spec.Sink)
 	if err != nil {
 		src.Status.MarkNoSink()
 		return reconciler.NewEvent(corev1.EventTypeWarning,
@@ -71,46 +89,222 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, src *v1alpha1.GitLabSour
 	}
 	src.Status.MarkSink(sinkURI)
 
-	adapter, err := r.reconcileAdapter(ctx, src)
+	// no receive adapter is deployed for sources whose hooks can't be
+	// configured
+	if unsupported := src.UnsupportedEventTypes(); len(unsupported) > 0 {
+		src.Status.MarkNoWebhook("UnsupportedEventTypes",
+			"Event types can not be enabled on the source's hooks: %s", strings.Join(unsupported, ", "))
+		return reconciler.NewEvent(corev1.EventTypeWarning, "UnsupportedEventTypes",
+			"Event types can not be enabled on the source's hooks: %s", strings.Join(unsupported, ", "))
+	}
+
+	if err := r.reconcileSecretToken(ctx, src); err != nil {
+		src.Status.MarkNotDeployed("SecretTokenError", "Error reconciling generated secret token: %s", err)
+		return fmt.Errorf("reconciling generated secret token: %w", err)
+	}
+
+	unlabeled, err := r.trackSecrets(ctx, src, secretRefs(src)...)
+	if err != nil {
+		return err
+	}
+	if len(unlabeled) > 0 {
+		src.Status.MarkSecretsNotWatched("%s", unlabeledSecretsMessage(unlabeled))
+	} else {
+		src.Status.MarkSecretsWatched()
+	}
+
+	// unlike dedicated receive adapters, the shared one reads secret tokens
+	// from its cache only
+	if ref := src.SecretTokenRef(); ref != nil && slices.Contains(unlabeled, ref.Name) &&
+		r.adapterMode(src.Spec.AdapterMode) == v1beta1.AdapterModeShared {
+
+		src.Status.MarkNotDeployed("SecretNotLabeled", "The shared receive adapter can only read "+
+			"the secret token from Secrets which carry the label %s", secret.WatchLabelSelector)
+		return nil
+	}
+
+	secretToken, err := r.reconcileSecretTokenRotation(ctx, src)
+	if err != nil {
+		src.Status.MarkNotDeployed("SecretTokenError", "Error reconciling rotation of secret token: %s", err)
+		return fmt.Errorf("reconciling rotation of secret token: %w", err)
+	}
+
+	var secretTokenHash string
+	if secretToken != "" {
+		secretTokenHash = r.hasher.Hash(secretToken)
+	}
+
+	adapter, err := r.reconcileAdapter(ctx, &adapterArgs{
+		owner:                  src,
+		mode:                   src.Spec.AdapterMode,
+		exposure:               src.Spec.Exposure,
+		webhookURL:             src.Spec.WebhookURL,
+		template:               src.Spec.Adapter,
+		serviceAccountName:     src.Spec.ServiceAccountName,
+		secretToken:            src.SecretTokenRef(),
+		previousSecretToken:    src.PreviousSecretTokenRef(),
+		secretTokenHash:        secretTokenHash,
+		eventSource:            src.AsEventSource(),
+		eventSourceFromPayload: src.IsMultiProjectSource(),
+		sinkURI:                src.Status.SinkURI,
+	})
 	if err != nil {
 		src.Status.MarkNotDeployed("FailedSync", "Error reconciling receive adapter: %s", err)
 		return fmt.Errorf("reconciling receive adapter: %w", err)
 	}
 
-	if !adapter.IsReady() {
-		src.Status.MarkNotDeployed("NotReady", "Receive adapter Service is not ready")
+	if adapter.outOfDate != "" {
+		src.Status.MarkAdapterOutOfDate(adapter.outOfDate)
+	} else {
+		src.Status.MarkAdapterUpToDate()
+	}
+
+	if !adapter.ready {
+		src.Status.MarkNotDeployed("NotReady", "Receive adapter is not ready")
 		return nil
 	}
 	src.Status.MarkDeployed()
 
-	adapterURL := adapter.Status.URL
+	adapterURL := adapter.url
 
 	// skip this cycle if the adapter's URL couldn't yet be determined
 	if adapterURL == nil {
 		return nil
 	}
 
-	hookID, err := syncWebhook(ctx, r.gitlabCg, src, adapterURL)
+	// during rotations, hooks only receive the new secret token once the
+	// receive adapter accepts it
+	if src.Status.SecretTokenRotation != nil && adapter.outOfDate != "" {
+		if adapter.upgradeDeferred {
+			return controller.NewRequeueAfter(upgradeRetryPeriod)
+		}
+		return nil
+	}
+
+	rotationRequeueAfter, err := r.reconcileAccessTokens(ctx, src)
+	if errors.Is(err, errProvisioningNotAllowed) {
+		// retried once the allow-list changes
+		src.Status.MarkNoWebhook("AccessTokenNotAllowed", "%s", err)
+		return controller.NewPermanentError(err)
+	}
 	if err != nil {
-		return err
+		src.Status.MarkNoWebhook("AccessTokenError", "Error provisioning GitLab access tokens: %s", err)
+		return fmt.Errorf("provisioning GitLab access tokens: %w", err)
 	}
 
-	src.Status.WebhookID = &hookID
-	src.Status.MarkWebhook()
+	event := r.syncWebhooks(ctx, src, adapterURL)
+
+	// tokens of projects removed from the spec are only revoked once
+	// their hooks are deleted
+	revokeErr := r.revokeUnusedAccessTokens(ctx, src)
+
+	var expiresAt *time.Time
+	if src.Status.AccessTokenExpiresAt != nil {
+		expiresAt = &src.Status.AccessTokenExpiresAt.Time
+	}
+	// provisioned tokens are rotated before they expire, so their expiry
+	// is only reported through metrics
+	expiryRecorder := controller.GetEventRecorder(ctx)
+	if src.DeclaredAccessTokenRef() == nil {
+		expiryRecorder = nil
+	}
+	expiryRequeueAfter := r.tokenExpiry.Observe(src, expiryRecorder, expiresAt)
+
+	if event != nil {
+		return event
+	}
+	if revokeErr != nil {
+		return revokeErr
+	}
+
+	requeueAfter, err := r.reconcileAppliedSecretToken(ctx, src, secretToken)
+	if err != nil {
+		return fmt.Errorf("recording applied secret token: %w", err)
+	}
+
+	requeueAfter = minRequeue(requeueAfter, expiryRequeueAfter)
+	requeueAfter = minRequeue(requeueAfter, rotationRequeueAfter)
+	if adapter.upgradeDeferred {
+		requeueAfter = minRequeue(requeueAfter, upgradeRetryPeriod)
+	}
+	if requeueAfter > 0 {
+		return controller.NewRequeueAfter(requeueAfter)
+	}
 
 	return nil
 }
 
-func (r *Reconciler) FinalizeKind(ctx context.Context, src *v1alpha1.GitLabSource) reconciler.Event {
-	currentHookID := src.Status.WebhookID
+// minRequeue returns the shortest of the given requeue durations, ignoring
+// zero durations, which don't require a requeue.
+func minRequeue(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
 
-	if currentHookID == nil {
-		return nil
+func (r *Reconciler) FinalizeKind(ctx context.Context, src *v1beta1.GitLabSource) reconciler.Event {
+	r.tokenExpiry.Forget(src)
+
+	var remainingHooks []v1beta1.WebhookStatus
+	var failures []string
+
+	for i := range src.Status.Webhooks {
+		hook := &src.Status.Webhooks[i]
+
+		if src.Spec.DeletionPolicy == v1beta1.DeletionPolicyRetain {
+			r.retainWebhook(ctx, src, hook)
+			continue
+		}
+
+		if err := r.deleteWebhook(ctx, src, hook); err != nil {
+			remainingHooks = append(remainingHooks, *hook)
+			failures = append(failures, fmt.Sprintf("%s: %s", hook.Target(), err))
+		}
+	}
+
+	src.Status.Webhooks = remainingHooks
+
+	if len(failures) > 0 {
+		src.Status.MarkNoWebhook("WebhookDeleteFailed",
+			"Error deleting webhooks: %s", strings.Join(failures, "; "))
+		return reconciler.NewEvent(corev1.EventTypeWarning,
+			"FailedWebhookDelete", "Error deleting webhooks: %s", strings.Join(failures, "; "))
 	}
 
-	gitlabCli, err := r.gitlabCg.Get(src)
+	// provisioned tokens are revoked once they are no longer needed for
+	// deleting hooks
+	if err := r.revokeAccessTokens(ctx, src, nil); err != nil {
+		if !isDenied(err) {
+			return err
+		}
+		// it is unlikely that we recover from auth errors in the
+		// finalizer, so we simply record a warning event and return
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeWarning, "FailedAccessTokenRevoke",
+			"Access denied to GitLab API while finalizing event source. Ignoring: %s", err)
+	}
+
+	return nil
+}
+
+// secretRefs returns the references to the Secrets of the given source.
+func secretRefs(src *v1beta1.GitLabSource) []*corev1.SecretKeySelector {
+	refs := make([]*corev1.SecretKeySelector, 0, len(src.WebhookTargets())+1)
+	for _, target := range src.WebhookTargets() {
+		refs = append(refs, src.AccessTokenRef(target))
+	}
+	return append(refs, src.SecretTokenRef())
+}
+
+// deleteWebhook removes the given hook from its GitLab project or group.
+// Errors which the finalizer is unlikely to recover from are recorded as
+// warning events and ignored.
+func (r *webhookReconciler) deleteWebhook(ctx context.Context, src *v1beta1.GitLabSource,
+	hook *v1beta1.WebhookStatus) error {
+
+	gitlabCli, err := r.gitlabCg.Get(src, hook)
 	switch {
-	case isSecretNotFound(err):
+	case isMissingCredentials(err):
 		// the finalizer is unlikely to recover from missing
 		// credentials, so we simply record a warning event and return
 		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeWarning, "FailedWebhookDelete",
@@ -125,186 +319,457 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, src *v1alpha1.GitLabSourc
 		return nil
 
 	case err != nil:
-		return reconciler.NewEvent(corev1.EventTypeWarning,
-			"ClientError", "Error obtaining GitLab webhook client: %s", err)
+		return fmt.Errorf("obtaining GitLab webhook client: %w", err)
 	}
 
-	if err := gitlabCli.Delete(*currentHookID); err != nil {
+	if err := gitlabCli.Delete(hook.ID); err != nil && !isHookNotFound(err) {
 		return err
 	}
 
-	src.Status.WebhookID = nil
-
 	return nil
 }
 
-// syncWebhook reconciles the GitLab project's webhook with its desired state.
-func syncWebhook(ctx context.Context, cg gitlab.WebhookClientGetter,
-	src *v1alpha1.GitLabSource, url *apis.URL) (hookID int, err error) {
+// retainWebhook marks the given hook as retained after the deletion of its
+// source, so that it is ignored by the garbage collection of orphaned hooks.
+// Since the hook is kept in any case, errors are recorded as warning events
+// and don't prevent the deletion of the source.
+func (r *webhookReconciler) retainWebhook(ctx context.Context, src *v1beta1.GitLabSource,
+	hook *v1beta1.WebhookStatus) {
+
+	err := func() error {
+		cli, err := r.gitlabCg.Get(src, hook)
+		if err != nil {
+			return fmt.Errorf("obtaining GitLab webhook client: %w", err)
+		}
+
+		current, err := cli.Get(hook.ID)
+		switch {
+		case isHookNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("retrieving webhook: %w", err)
+		}
+
+		current.Description = retainedWebhookMarker(src.Namespace, src.Name, r.clusterID)
+		if _, err := cli.Edit(current); err != nil {
+			return fmt.Errorf("updating webhook: %w", err)
+		}
+
+		return nil
+	}()
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeWarning, "FailedWebhookRetain",
+			"Failed to mark webhook of %s as retained. Ignoring: %s", hook.Target(), err)
+		return
+	}
+
+	controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "WebhookRetained",
+		"Retained webhook %d of %s", hook.ID, hook.Target())
+}
+
+// syncWebhooks reconciles the hooks of the GitLab projects or group with
+// their desired state, and removes the hooks of projects which are no longer
+// part of the source's spec.
+func (r *webhookReconciler) syncWebhooks(ctx context.Context, src *v1beta1.GitLabSource,
+	url *apis.URL) reconciler.Event {
+
+	currentHooks := make(map[string]v1beta1.WebhookStatus, len(src.Status.Webhooks))
+	for _, hook := range src.Status.Webhooks {
+		currentHooks[hook.Target()] = hook
+	}
+
+	var hooks []v1beta1.WebhookStatus
+	var failures []string
+	var drifts []string
+	var permanentErr error
+	var credsErr *credentialsError
+	var unverifiedErr error
+
+	for _, target := range src.WebhookTargets() {
+		hook, hasHook := currentHooks[target]
+		delete(currentHooks, target)
+
+		var currentHookID *int
+		if hasHook {
+			currentHookID = &hook.ID
+		} else {
+			hook = src.NewWebhookStatus(target, 0)
+		}
+
+		drifted, err := r.syncWebhook(ctx, src, &hook, currentHookID, url)
+		if err != nil {
+			if hasHook {
+				hooks = append(hooks, hook)
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", target, err))
+			switch {
+			case isMissingCredentials(err):
+				permanentErr = err
+			case errors.As(err, &credsErr):
+				// reported below with the reason of the failure
+			case errors.Is(err, errCredentialsUnverified):
+				unverifiedErr = err
+			}
+			continue
+		}
+
+		if len(drifted) > 0 {
+			drifts = append(drifts, fmt.Sprintf("%s: %s", target, strings.Join(drifted, ", ")))
+		}
+		hooks = append(hooks, hook)
+	}
+
+	// hooks that remain in the map belong to projects which were removed
+	// from the source's spec
+	for _, hook := range currentHooks {
+		if err := r.deleteWebhook(ctx, src, &hook); err != nil {
+			hooks = append(hooks, hook)
+			failures = append(failures, fmt.Sprintf("%s: %s", hook.Target(), err))
+		}
+	}
+
+	sort.Slice(hooks, func(i, j int) bool {
+		return hooks[i].Target() < hooks[j].Target()
+	})
+	src.Status.Webhooks = hooks
 
-	cli, err := cg.Get(src)
 	switch {
-	case isSecretNotFound(err):
-		src.Status.MarkNoWebhook("MissingCredentials", "Error obtaining credentials for GitLab API: %s", err)
-		return -1, reconciler.NewEvent(corev1.EventTypeWarning,
-			"AuthError", "Error obtaining credentials for GitLab API: %s", err)
+	case len(drifts) > 0:
+		src.Status.MarkWebhookDrift("WebhookDrifted",
+			"Webhook configuration differs from the spec: %s", strings.Join(drifts, "; "))
+	case len(failures) == 0:
+		src.Status.MarkWebhookInSync()
+	}
 
-	case err != nil:
-		src.Status.MarkNoWebhook("ClientError", "Error obtaining GitLab webhook client: %s", err)
+	switch {
+	case permanentErr != nil:
+		src.Status.MarkCredentialsInvalid(missingCredentialsReason(permanentErr),
+			"Error obtaining credentials for GitLab API: %s", permanentErr)
+	case credsErr != nil:
+		src.Status.MarkCredentialsInvalid(credsErr.reason,
+			"GitLab API token can't manage webhooks: %s", credsErr)
+	case unverifiedErr != nil:
+		src.Status.MarkCredentialsUnknown("VerificationFailed",
+			"Error verifying GitLab API token: %s", unverifiedErr)
+	default:
+		src.Status.MarkCredentialsValid()
+	}
+
+	switch {
+	case permanentErr != nil:
+		src.Status.MarkNoWebhook(missingCredentialsReason(permanentErr),
+			"Error obtaining credentials for GitLab API: %s", permanentErr)
+		return reconciler.NewEvent(corev1.EventTypeWarning,
+			"AuthError", "Error obtaining credentials for GitLab API: %s", permanentErr)
+
+	case credsErr != nil:
+		src.Status.MarkNoWebhook(credsErr.reason, "Error configuring webhooks: %s", strings.Join(failures, "; "))
+		// permissions may be granted in GitLab at any time, so the
+		// reconciliation is retried
+		return fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
+			"AuthError", "GitLab API token can't manage webhooks: %s", strings.Join(failures, "; ")))
+
+	case len(failures) > 0:
+		src.Status.MarkNoWebhook("WebhookError", "Error configuring webhooks: %s", strings.Join(failures, "; "))
 		// wrap reconciler events to fail (and retry) the reconciliation
-		return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
-			"ClientError", "Error obtaining GitLab webhook client: %s", err))
+		return fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
+			"WebhookError", "Error configuring webhooks: %s", strings.Join(failures, "; ")))
 	}
 
-	currentHookID := src.Status.WebhookID
+	src.Status.MarkWebhook()
+
+	return nil
+}
+
+// syncWebhook reconciles the hook of a single GitLab project or group with
+// its desired state, and records the hook's observed state in the given
+// status.
+//
+// Hooks which aren't recorded in the status, but which were registered for the
+// source, are adopted instead of being duplicated.
+// Existing hooks are only edited when their configuration drifted from the
+// desired state. When the source's drift policy is to report drift instead
+// of correcting it, the names of the drifted attributes are returned.
+func (r *webhookReconciler) syncWebhook(ctx context.Context, src *v1beta1.GitLabSource,
+	hook *v1beta1.WebhookStatus, currentHookID *int, url *apis.URL) (drifted []string, err error) {
+
+	cli, err := r.gitlabCg.Get(src, hook)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining GitLab webhook client: %w", err)
+	}
 
-	if currentHookID == nil {
-		hookID, err := cli.Add(src.Spec.EventTypes, url, src.Spec.SSLVerify)
+	// a token which can't manage hooks would otherwise only be reported
+	// by the failure of the first request to the hooks API. Verifications
+	// are cached, and repeated only before the hook is changed, or when
+	// GitLab denies a request, to report the cause of the denial.
+	verified, err := r.checkCredentials(ctx, cli, src, hook, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ensureVerified := func() error {
+		if verified {
+			return nil
+		}
+		verified = true
+		_, err := r.checkCredentials(ctx, cli, src, hook, true)
+		return err
+	}
+
+	denied := func(err error) error {
+		if !isDenied(err) {
+			return err
+		}
+		if _, verr := r.checkCredentials(ctx, cli, src, hook, true); verr != nil {
+			return verr
+		}
+		return err
+	}
+
+	desired := r.desiredWebhook(src, url)
+	tokenHash := cli.SecretTokenHash()
+
+	addHook := func() ([]string, error) {
+		if err := ensureVerified(); err != nil {
+			return nil, err
+		}
+
+		created, err := cli.Add(desired)
 		if err != nil {
-			src.Status.MarkNoWebhook("WebhookError", "Error adding webhook: %s", err)
-			return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
-				"WebhookError", "Error adding webhook: %s", err))
+			return nil, denied(fmt.Errorf("adding webhook: %w", err))
 		}
 
 		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal,
-			"WebHookCreated", "Project webhook created successfully")
+			"WebHookCreated", "Webhook created successfully for %s", hook.Target())
 
-		return hookID, nil
+		observeWebhook(ctx, cli, hook, created, true)
+		hook.SecretTokenHash = tokenHash
+
+		return nil, nil
 	}
 
-	_, err = cli.Get(*currentHookID)
-	switch {
-	case isHookNotFound(err):
-		hookID, err := cli.Add(src.Spec.EventTypes, url, src.Spec.SSLVerify)
+	var current *gitlab.Hook
+	var adopted bool
+
+	if currentHookID != nil {
+		current, err = cli.Get(*currentHookID)
+		switch {
+		case isHookNotFound(err):
+			current = nil
+
+		case err != nil:
+			return nil, denied(fmt.Errorf("retrieving webhook: %w", err))
+		}
+	}
+
+	// the hook's ID may be unknown or stale, e.g. after the source was
+	// re-created, in which case we look for a hook which was previously
+	// registered for the source before adding a new one
+	if current == nil {
+		hooks, err := cli.List()
 		if err != nil {
-			src.Status.MarkNoWebhook("WebhookError", "Error adding webhook: %s", err)
-			return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
-				"WebhookError", "Error adding webhook: %s", err))
+			return nil, denied(fmt.Errorf("listing webhooks: %w", err))
 		}
 
-		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal,
-			"WebHookCreated", "Project webhook created successfully")
+		if current = r.findWebhook(hooks, desired, src); current == nil {
+			return addHook()
+		}
 
-		return hookID, nil
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "WebhookAdopted",
+			"Adopted existing webhook %d for %s", current.ID, hook.Target())
+		adopted = true
 
-	case err != nil:
-		src.Status.MarkNoWebhook("WebhookError", "Error retrieving webhook: %s", err)
-		return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
-			"WebhookError", "Error retrieving webhook: %s", err))
+		// the token applied to the adopted hook is unknown
+		hook.SecretTokenHash = ""
+	}
+
+	drifted = current.Diff(desired)
+
+	// GitLab never returns secret tokens, so changes are detected by
+	// comparing the token's hash with the one recorded when it was last
+	// applied. Hooks without a recorded hash, or with a hash computed by a
+	// previous version of the controller, are updated silently, since their
+	// token is unknown.
+	tokenUnknown := !gitlab.IsSecretTokenHash(hook.SecretTokenHash)
+	if !tokenUnknown && hook.SecretTokenHash != tokenHash {
+		drifted = append(drifted, "secretToken")
+	}
+
+	reportOnly := src.Spec.WebhookDriftPolicy == v1beta1.WebhookDriftPolicyReport
+	if reportOnly || (len(drifted) == 0 && !tokenUnknown) {
+		observeWebhook(ctx, cli, hook, current, adopted)
+		if reportOnly {
+			return drifted, nil
+		}
+		return nil, nil
 	}
 
-	err = cli.Edit(*currentHookID, src.Spec.EventTypes, url, src.Spec.SSLVerify)
+	if err := ensureVerified(); err != nil {
+		return nil, err
+	}
+
+	desired.ID = current.ID
+	edited, err := cli.Edit(desired)
 	if err != nil {
-		src.Status.MarkNoWebhook("WebhookError", "Error updating webhook: %s", err)
-		return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
-			"WebhookError", "Error updating webhook: %s", err))
+		return nil, denied(fmt.Errorf("updating webhook: %w", err))
 	}
 
-	return *currentHookID, nil
+	if len(drifted) > 0 {
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "WebhookDriftCorrected",
+			"Corrected drifted webhook attributes for %s: %s", hook.Target(), strings.Join(drifted, ", "))
+	}
+
+	observeWebhook(ctx, cli, hook, edited, adopted)
+	hook.SecretTokenHash = tokenHash
+
+	return nil, nil
 }
 
-// resolveSinkURL resolves the URL of a sink reference.
-func resolveSinkURL(ctx context.Context, r *resolver.URIResolver, src *v1alpha1.GitLabSource) (*apis.URL, error) {
-	sink := src.Spec.Sink
+// checkCredentials verifies that the API token used by the given client is
+// allowed to manage the hooks of the given hook's project or group, and
+// records the token's expiry in the source's status. Unless force is true, a
+// verification cached within the last credentialsTTL is reused, in which
+// case the returned boolean is false.
+func (r *webhookReconciler) checkCredentials(ctx context.Context, cli gitlab.WebhookClient,
+	src *v1beta1.GitLabSource, hook *v1beta1.WebhookStatus, force bool) (verified bool, err error) {
 
-	if sinkRef := &sink.Ref; *sinkRef != nil && (*sinkRef).Namespace == "" {
-		(*sinkRef).Namespace = src.Namespace
+	key := credentialsKey{tokenHash: cli.AccessTokenHash(), target: hook.Target()}
+
+	creds := r.credentials.get(key)
+	if force || creds == nil {
+		r.credentials.forget(key)
+		verified = true
+
+		creds, err = verifyCredentials(ctx, cli, hook, src.Status.AccessTokenExpiresAt)
+		if err == nil {
+			r.credentials.set(key, creds)
+		}
+	}
+
+	if creds != nil {
+		// all hooks are managed with the same token
+		src.Status.AccessTokenExpiresAt = nil
+		if creds.ExpiresAt != nil {
+			src.Status.AccessTokenExpiresAt = &metav1.Time{Time: *creds.ExpiresAt}
+		}
 	}
 
-	return r.URIFromDestinationV1(ctx, sink, src)
+	return verified, err
 }
 
-// reconcileAdapter reconciles the state of the source's adapter.
-func (r *Reconciler) reconcileAdapter(ctx context.Context, src *v1alpha1.GitLabSource) (*servingv1.Service, error) {
-	adapter, err := r.getOwnedKnativeService(ctx, src)
-	switch {
-	case apierrors.IsNotFound(err):
-		adapter = r.generateKnativeServiceObject(src, r.receiveAdapterImage)
-		adapter, err = r.ksvcCli(src.Namespace).Create(ctx, adapter, metav1.CreateOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("creating receive adapter: %w", err)
-		}
+// observeWebhook records the state of a hook observed in GitLab in the given
+// status.
+//
+// The project or group of the hook is only retrieved when refreshOwner is
+// true, i.e. when the hook was created or adopted, or when it isn't recorded
+// yet. It is otherwise identified by the same URL as when it was recorded.
+func observeWebhook(ctx context.Context, cli gitlab.WebhookClient,
+	hook *v1beta1.WebhookStatus, observed *gitlab.Hook, refreshOwner bool) {
+
+	hook.ID = observed.ID
+	hook.URL = observed.URL
+	hook.EventTypes = observed.EventTypes
+	hook.SSLVerify = observed.EnableSSLVerification
+	hook.AlertStatus = observed.AlertStatus
+	hook.DisabledUntil = nil
+	if observed.DisabledUntil != nil {
+		hook.DisabledUntil = &metav1.Time{Time: *observed.DisabledUntil}
+	}
+	hook.ObservedConfigHash = observed.ConfigHash()
 
-	case err != nil:
-		return nil, fmt.Errorf("searching for existing receive adapter: %w", err)
+	if !refreshOwner && hook.OwnerID != 0 && hook.PathWithNamespace != "" {
+		return
 	}
 
-	return adapter, nil
+	// the path of the project or group is informational, failing to
+	// retrieve it shouldn't prevent the hook's ID from being recorded
+	owner, err := cli.Owner()
+	if err != nil {
+		logging.FromContext(ctx).Warnw("Failed to retrieve GitLab project or group of webhook",
+			zap.String("target", hook.Target()), zap.Error(err))
+		hook.OwnerID = observed.OwnerID
+		return
+	}
+
+	hook.OwnerID = owner.ID
+	hook.PathWithNamespace = owner.FullPath
 }
 
-func (r *Reconciler) generateKnativeServiceObject(source *v1alpha1.GitLabSource, receiveAdapterImage string) *servingv1.Service {
-	labels := map[string]string{
-		"receive-adapter": "gitlab",
-	}
-
-	env := append([]corev1.EnvVar{
-		{
-			Name: "GITLAB_SECRET_TOKEN",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: source.Spec.SecretToken.SecretKeyRef,
-			},
-		}, {
-			Name:  "GITLAB_EVENT_SOURCE",
-			Value: source.AsEventSource(),
-		}, {
-			Name:  "K_SINK",
-			Value: source.Status.SinkURI.String(),
-		}, {
-			Name:  "NAMESPACE",
-			Value: source.GetNamespace(),
-		}, {
-			Name:  "METRICS_DOMAIN",
-			Value: "knative.dev/eventing",
-		}, {
-			Name:  "METRICS_PROMETHEUS_PORT",
-			Value: "9092",
-		}},
-		r.configs.ToEnvVars()...)
-
-	return &servingv1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: fmt.Sprintf("%s-", source.Name),
-			Namespace:    source.Namespace,
-			Labels:       labels,
-			OwnerReferences: []metav1.OwnerReference{
-				*kmeta.NewControllerRef(source),
-			},
-		},
-		Spec: servingv1.ServiceSpec{
-			ConfigurationSpec: servingv1.ConfigurationSpec{
-				Template: servingv1.RevisionTemplateSpec{
-					Spec: servingv1.RevisionSpec{
-						PodSpec: corev1.PodSpec{
-							ServiceAccountName: source.Spec.ServiceAccountName,
-							Containers: []corev1.Container{
-								{
-									Image: receiveAdapterImage,
-									Env:   env,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+// desiredWebhook returns the desired configuration of the source's hooks.
+func (r *webhookReconciler) desiredWebhook(src *v1beta1.GitLabSource, url *apis.URL) *gitlab.Hook {
+	return &gitlab.Hook{
+		URL:                    url.String(),
+		Description:            webhookMarker(src, r.clusterID),
+		EventTypes:             src.Spec.EventTypes,
+		EnableSSLVerification:  src.Spec.SSLVerify,
+		PushEventsBranchFilter: src.Spec.PushEventsBranchFilter,
+		BranchFilterStrategy:   src.Spec.BranchFilterStrategy,
 	}
 }
 
-func (r *Reconciler) getOwnedKnativeService(ctx context.Context, source *v1alpha1.GitLabSource) (*servingv1.Service, error) {
-	list, err := r.ksvcCli(source.GetNamespace()).List(ctx, metav1.ListOptions{
-		LabelSelector: labels.Everything().String(),
-	})
+// webhookMarkerPrefix is the prefix of the description of the hooks
+// registered by the controller, followed by the namespaced name of their
+// source, and by the identifier of the source's cluster within
+// webhookMarkerClusterInfix and ")". Hooks registered by previous versions of
+// the controller don't carry the identifier of their cluster.
+const (
+	webhookMarkerPrefix       = "Managed by Knative GitLabSource "
+	webhookMarkerClusterInfix = " (cluster "
+)
 
-	if err != nil {
-		return nil, err
+// retainedWebhookMarkerPrefix is the prefix of the description of the hooks
+// retained after the deletion of their source, followed by the namespaced
+// name of the deleted source, and by the identifier of its cluster within
+// webhookMarkerClusterInfix and ")".
+const retainedWebhookMarkerPrefix = "Retained by Knative GitLabSource "
+
+// webhookMarker returns the description of the source's hooks, which
+// identifies them as belonging to the source in the cluster with the given
+// identifier.
+func webhookMarker(src *v1beta1.GitLabSource, clusterID string) string {
+	return webhookMarkerPrefix + src.Namespace + "/" + src.Name + webhookMarkerClusterInfix + clusterID + ")"
+}
+
+// retainedWebhookMarker returns the description of the hooks of the source
+// with the given namespace and name, in the cluster with the given
+// identifier, after they were retained upon the deletion of the source.
+func retainedWebhookMarker(namespace, name, clusterID string) string {
+	return retainedWebhookMarkerPrefix + namespace + "/" + name + webhookMarkerClusterInfix + clusterID + ")"
+}
+
+// findWebhook returns the hook which matches either the URL or the marker of
+// the desired hook, or the marker set on the source's hooks by previous
+// versions of the controller. Otherwise, it returns a hook retained after the
+// deletion of a source with the same namespace and name in the same cluster,
+// or of the source named in the spec's AdoptWebhooksFrom, or nil if no hook
+// matches.
+func (r *webhookReconciler) findWebhook(hooks []*gitlab.Hook, desired *gitlab.Hook,
+	src *v1beta1.GitLabSource) *gitlab.Hook {
+
+	legacyMarker := webhookMarkerPrefix + src.Namespace + "/" + src.Name
+
+	for _, h := range hooks {
+		if h.URL == desired.URL || h.Description == desired.Description || h.Description == legacyMarker {
+			return h
+		}
+	}
+
+	retainedMarkers := []string{retainedWebhookMarker(src.Namespace, src.Name, r.clusterID)}
+	if ref := src.Spec.AdoptWebhooksFrom; ref != nil {
+		clusterID := ref.Cluster
+		if clusterID == "" {
+			clusterID = r.clusterID
+		}
+		retainedMarkers = append(retainedMarkers, retainedWebhookMarker(ref.Namespace, ref.Name, clusterID))
 	}
-	for _, ksvc := range list.Items {
-		if metav1.IsControlledBy(&ksvc, source) {
-			return &ksvc, nil
+
+	for _, h := range hooks {
+		if slices.Contains(retainedMarkers, h.Description) {
+			return h
 		}
 	}
 
-	return nil, apierrors.NewNotFound(servingv1.Resource("services"), "")
+	return nil
 }
 
 // CreateCloudEventAttributes returns CloudEvent attributes for the event types
@@ -322,10 +787,29 @@ func CreateCloudEventAttributes(source string, eventTypes []string) []duckv1.Clo
 	return ceAttributes
 }
 
-// isSecretNotFound returns whether the given error indicates that a Kubernetes
-// Secret does not exist.
-func isSecretNotFound(err error) bool {
-	return apierrors.IsNotFound(err)
+// isMissingCredentials returns whether the given error indicates that the
+// credentials referenced by a source can't be obtained, because their Secret,
+// key or value is missing.
+func isMissingCredentials(err error) bool {
+	return missingCredentialsReason(err) != ""
+}
+
+// missingCredentialsReason returns the reason of the condition which reports
+// the given error obtaining the credentials referenced by a source, or an
+// empty string if the error doesn't indicate missing credentials.
+func missingCredentialsReason(err error) string {
+	switch {
+	case errors.Is(err, secret.ErrSecretNotFound):
+		return "SecretNotFound"
+	case errors.Is(err, secret.ErrKeyNotFound):
+		return "SecretKeyNotFound"
+	case errors.Is(err, secret.ErrEmptyValue):
+		return "EmptySecretValue"
+	case errors.Is(err, gitlab.ErrAccessTokenNotProvisioned):
+		return "AccessTokenNotProvisioned"
+	default:
+		return ""
+	}
 }
 
 // isHookNotFound returns whether the given error indicates that a GitLab
@@ -338,10 +822,17 @@ func isHookNotFound(err error) bool {
 }
 
 // isDenied returns whether the given error indicates that an API request to
-// GitLab was denied.
+// GitLab was denied, either because the API token is invalid or because it
+// lacks the permission to perform the request.
 func isDenied(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized) || hasStatusCode(err, http.StatusForbidden)
+}
+
+// hasStatusCode returns whether the given error is a GitLab API error with the
+// given HTTP status code.
+func hasStatusCode(err error, code int) bool {
 	if glErr := (*gogitlab.ErrorResponse)(nil); errors.As(err, &glErr) {
-		return glErr.Response.StatusCode == http.StatusUnauthorized
+		return glErr.Response.StatusCode == code
 	}
 	return false
 }
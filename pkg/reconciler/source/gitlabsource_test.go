@@ -0,0 +1,463 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/apis/duck"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+)
+
+func TestSyncWebhookAdoption(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Description of the hook which exists in GitLab.
+		existing string
+		// Retained hooks named in the source's spec.
+		adoptFrom *v1beta1.RetainedWebhooksRef
+
+		expectAdopted bool
+	}{
+		{
+			name:          "Hook of the source is adopted",
+			existing:      webhookMarker(newTestGitLabSource(), testClusterID),
+			expectAdopted: true,
+		},
+		{
+			name:          "Hook registered by previous versions is adopted",
+			existing:      webhookMarkerPrefix + testNamespace + "/source",
+			expectAdopted: true,
+		},
+		{
+			name:     "Hook of other source is ignored",
+			existing: webhookMarkerPrefix + testNamespace + "/other" + webhookMarkerClusterInfix + testClusterID + ")",
+		},
+		{
+			name:     "Unmanaged hook is ignored",
+			existing: "Something else",
+		},
+		{
+			name:          "Retained hook of the same source is adopted",
+			existing:      retainedWebhookMarker(testNamespace, "source", testClusterID),
+			expectAdopted: true,
+		},
+		{
+			name:     "Retained hook of source with the same name in other namespace is ignored",
+			existing: retainedWebhookMarker("team-b", "source", testClusterID),
+		},
+		{
+			name:          "Retained hook of named source in other namespace is adopted",
+			existing:      retainedWebhookMarker("team-b", "source", testClusterID),
+			adoptFrom:     &v1beta1.RetainedWebhooksRef{Namespace: "team-b", Name: "source"},
+			expectAdopted: true,
+		},
+		{
+			name:     "Retained hook of source with the same name in other cluster is ignored",
+			existing: retainedWebhookMarker(testNamespace, "source", "cluster-b"),
+		},
+		{
+			name:      "Retained hook of named source in other cluster requires the cluster",
+			existing:  retainedWebhookMarker("team-b", "previous", "cluster-b"),
+			adoptFrom: &v1beta1.RetainedWebhooksRef{Namespace: "team-b", Name: "previous"},
+		},
+		{
+			name:     "Retained hook of named source in other cluster is adopted",
+			existing: retainedWebhookMarker("team-b", "previous", "cluster-b"),
+			adoptFrom: &v1beta1.RetainedWebhooksRef{
+				Namespace: "team-b",
+				Name:      "previous",
+				Cluster:   "cluster-b",
+			},
+			expectAdopted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gl := newFakeGitLab()
+			existingID := gl.addHook(testProjectURL, &gitlab.Hook{
+				URL:         "https://previous.example.com",
+				Description: tc.existing,
+				EventTypes:  []string{v1beta1.GitLabWebhookPush},
+			})
+
+			src := newTestGitLabSource()
+			src.Spec.AdoptWebhooksFrom = tc.adoptFrom
+
+			r := newTestWebhookReconciler(gl)
+			hook := &v1beta1.WebhookStatus{ProjectURL: testProjectURL}
+			ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+			_, err := r.syncWebhook(ctx, src, hook, nil, apis.HTTPS("adapter.example.com"))
+			require.NoError(t, err)
+
+			existing := gl.hooks[testProjectURL][existingID]
+			if tc.expectAdopted {
+				assert.Equal(t, existingID, hook.ID, "adopted hook")
+				assert.Len(t, gl.hooks[testProjectURL], 1, "hooks of the project")
+				assert.Equal(t, webhookMarker(src, testClusterID), existing.Description)
+				assert.Equal(t, "https://adapter.example.com", existing.URL)
+			} else {
+				assert.NotEqual(t, existingID, hook.ID, "adopted hook")
+				assert.Len(t, gl.hooks[testProjectURL], 2, "hooks of the project")
+				assert.Equal(t, tc.existing, existing.Description)
+			}
+		})
+	}
+}
+
+// TestSyncWebhookAdoptionByURL ensures that a hook which delivers events to
+// the receive adapter is adopted, whatever its description.
+func TestSyncWebhookAdoptionByURL(t *testing.T) {
+	gl := newFakeGitLab()
+	existingID := gl.addHook(testProjectURL, &gitlab.Hook{
+		URL:         "https://adapter.example.com",
+		Description: "Something else",
+	})
+
+	r := newTestWebhookReconciler(gl)
+	hook := &v1beta1.WebhookStatus{ProjectURL: testProjectURL}
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	_, err := r.syncWebhook(ctx, newTestGitLabSource(), hook, nil, apis.HTTPS("adapter.example.com"))
+	require.NoError(t, err)
+
+	assert.Equal(t, existingID, hook.ID, "adopted hook")
+	assert.Len(t, gl.hooks[testProjectURL], 1, "hooks of the project")
+	assert.Zero(t, gl.calls["Add"], "added hooks")
+}
+
+// TestSyncWebhookStaleID ensures that a hook of the source is adopted when the
+// hook recorded in the status of the source no longer exists.
+func TestSyncWebhookStaleID(t *testing.T) {
+	src := newTestGitLabSource()
+
+	gl := newFakeGitLab()
+	existingID := gl.addHook(testProjectURL, &gitlab.Hook{
+		URL:         "https://adapter.example.com",
+		Description: webhookMarker(src, testClusterID),
+		EventTypes:  []string{v1beta1.GitLabWebhookPush},
+	})
+
+	r := newTestWebhookReconciler(gl)
+	staleID := existingID + 1
+	hook := &v1beta1.WebhookStatus{
+		ProjectURL:      testProjectURL,
+		ID:              staleID,
+		SecretTokenHash: testSecretTokenHasher.Hash(testSecretToken),
+	}
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+	gl.failures["List"] = newGitLabError(http.StatusInternalServerError)
+	_, err := r.syncWebhook(ctx, src, hook, &staleID, apis.HTTPS("adapter.example.com"))
+	require.Error(t, err)
+	assert.Zero(t, gl.calls["Add"], "added hooks")
+
+	delete(gl.failures, "List")
+	_, err = r.syncWebhook(ctx, src, hook, &staleID, apis.HTTPS("adapter.example.com"))
+	require.NoError(t, err)
+
+	assert.Equal(t, existingID, hook.ID, "adopted hook")
+	assert.Len(t, gl.hooks[testProjectURL], 1, "hooks of the project")
+	assert.Zero(t, gl.calls["Add"], "added hooks")
+	// the secret token applied to the adopted hook is unknown
+	assert.Equal(t, 1, gl.calls["Edit"], "edits of the adopted hook")
+	assert.Equal(t, testSecretTokenHasher.Hash(testSecretToken), hook.SecretTokenHash)
+
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "WebhookAdopted")
+}
+
+func TestRetainWebhook(t *testing.T) {
+	gl := newFakeGitLab()
+
+	src := newTestGitLabSource()
+	src.Spec.DeletionPolicy = v1beta1.DeletionPolicyRetain
+
+	id := gl.addHook(testProjectURL, &gitlab.Hook{Description: webhookMarker(src, testClusterID)})
+
+	r := newTestWebhookReconciler(gl)
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	r.retainWebhook(ctx, src, &v1beta1.WebhookStatus{ProjectURL: testProjectURL, ID: id})
+
+	assert.Equal(t, "Retained by Knative GitLabSource team-a/source (cluster cluster-a)",
+		gl.hooks[testProjectURL][id].Description)
+}
+
+func TestSyncWebhookSecretToken(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Hash of the secret token recorded in the status of the hook.
+		recordedHash string
+
+		expectEdited  bool
+		expectDrifted bool
+	}{
+		{
+			name:         "Hook uses the secret token",
+			recordedHash: testSecretTokenHasher.Hash(testSecretToken),
+		},
+		{
+			name:          "Secret token changed",
+			recordedHash:  testSecretTokenHasher.Hash("previous-token"),
+			expectEdited:  true,
+			expectDrifted: true,
+		},
+		{
+			name:         "Secret token is unknown",
+			expectEdited: true,
+		},
+		{
+			name:         "Hash recorded by previous versions is replaced",
+			recordedHash: "8d969eef6ecad3c29a3a629280e686cf0c3f5d5a86aff3ca12020c923adc6c92",
+			expectEdited: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := newTestGitLabSource()
+
+			gl := newFakeGitLab()
+			r := newTestWebhookReconciler(gl)
+			url := apis.HTTPS("adapter.example.com")
+			id := gl.addHook(testProjectURL, r.desiredWebhook(src, url))
+
+			hook := &v1beta1.WebhookStatus{ProjectURL: testProjectURL, ID: id, SecretTokenHash: tc.recordedHash}
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+			_, err := r.syncWebhook(ctx, src, hook, &id, url)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectEdited, gl.calls["Edit"] > 0, "hook edited")
+			assert.Equal(t, testSecretTokenHasher.Hash(testSecretToken), hook.SecretTokenHash, "recorded hash")
+
+			var drifted bool
+			for len(recorder.Events) > 0 {
+				drifted = drifted || strings.Contains(<-recorder.Events, "WebhookDriftCorrected")
+			}
+			assert.Equal(t, tc.expectDrifted, drifted, "drift corrected")
+		})
+	}
+}
+
+func TestSyncWebhookCredentials(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Changes to the GitLab instance between the first and second
+		// reconciliations of the hook.
+		change func(gl *fakeGitLab, hookID int)
+
+		expectVerifications int
+		expectReason        string
+	}{
+		{
+			name:                "Verification is reused by subsequent reconciliations",
+			change:              func(*fakeGitLab, int) {},
+			expectVerifications: 1,
+		},
+		{
+			name: "Token is verified before the hook is added",
+			change: func(gl *fakeGitLab, hookID int) {
+				delete(gl.hooks[testProjectURL], hookID)
+			},
+			expectVerifications: 2,
+		},
+		{
+			name: "Token is verified before the hook is edited",
+			change: func(gl *fakeGitLab, hookID int) {
+				gl.hooks[testProjectURL][hookID].URL = "https://previous.example.com"
+			},
+			expectVerifications: 2,
+		},
+		{
+			name: "Token is verified again after a denied request",
+			change: func(gl *fakeGitLab, _ int) {
+				gl.creds.Active = false
+				gl.failures["Get"] = newGitLabError(http.StatusUnauthorized)
+			},
+			expectVerifications: 2,
+			expectReason:        reasonTokenRevoked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := newTestGitLabSource()
+			url := apis.HTTPS("adapter.example.com")
+
+			gl := newFakeGitLab()
+			r := newTestWebhookReconciler(gl)
+			hook := &v1beta1.WebhookStatus{ProjectURL: testProjectURL}
+			ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+			_, err := r.syncWebhook(ctx, src, hook, nil, url)
+			require.NoError(t, err)
+
+			tc.change(gl, hook.ID)
+
+			id := hook.ID
+			_, err = r.syncWebhook(ctx, src, hook, &id, url)
+
+			if tc.expectReason == "" {
+				require.NoError(t, err)
+			} else {
+				var credsErr *credentialsError
+				require.ErrorAs(t, err, &credsErr)
+				assert.Equal(t, tc.expectReason, credsErr.reason)
+			}
+			assert.Equal(t, tc.expectVerifications, gl.calls["Credentials"], "verifications")
+		})
+	}
+}
+
+// TestSyncWebhookOwner ensures that the project or group of a hook is only
+// retrieved when the hook is created or adopted.
+func TestSyncWebhookOwner(t *testing.T) {
+	src := newTestGitLabSource()
+	url := apis.HTTPS("adapter.example.com")
+
+	gl := newFakeGitLab()
+	r := newTestWebhookReconciler(gl)
+	hook := &v1beta1.WebhookStatus{ProjectURL: testProjectURL}
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	_, err := r.syncWebhook(ctx, src, hook, nil, url)
+	require.NoError(t, err)
+	assert.Equal(t, 1, gl.calls["Owner"], "retrievals after creation")
+	assert.Equal(t, testProjectURL, hook.PathWithNamespace)
+
+	id := hook.ID
+	_, err = r.syncWebhook(ctx, src, hook, &id, url)
+	require.NoError(t, err)
+	gl.hooks[testProjectURL][id].URL = "https://previous.example.com"
+	_, err = r.syncWebhook(ctx, src, hook, &id, url)
+	require.NoError(t, err)
+	assert.Equal(t, 1, gl.calls["Owner"], "retrievals after reconciliations of the same hook")
+
+	delete(gl.hooks[testProjectURL], id)
+	_, err = r.syncWebhook(ctx, src, hook, &id, url)
+	require.NoError(t, err)
+	assert.Equal(t, 2, gl.calls["Owner"], "retrievals after re-creation")
+}
+
+func TestSyncWebhookObservedStatus(t *testing.T) {
+	src := newTestGitLabSource()
+	url := apis.HTTPS("adapter.example.com")
+
+	gl := newFakeGitLab()
+	r := newTestWebhookReconciler(gl)
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	desired := r.desiredWebhook(src, url)
+	disabledUntil := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	observed := copyHook(desired)
+	observed.OwnerID = 7
+	observed.AlertStatus = "temporarily_disabled"
+	observed.DisabledUntil = &disabledUntil
+	id := gl.addHook(testProjectURL, observed)
+
+	// the project of the hook can't be retrieved, its ID is nevertheless
+	// known from the hook
+	gl.failures["Owner"] = newGitLabError(http.StatusForbidden)
+
+	hook := src.NewWebhookStatus(testProjectURL, id)
+	hook.SecretTokenHash = testSecretTokenHasher.Hash(testSecretToken)
+	_, err := r.syncWebhook(ctx, src, &hook, &id, url)
+	require.NoError(t, err)
+	require.Zero(t, gl.calls["Edit"], "edits of the hook in sync")
+
+	assert.Equal(t, id, hook.ID)
+	assert.Equal(t, url.String(), hook.URL)
+	assert.Equal(t, []string{v1beta1.GitLabWebhookPush}, hook.EventTypes)
+	assert.Equal(t, "temporarily_disabled", hook.AlertStatus)
+	require.NotNil(t, hook.DisabledUntil)
+	assert.True(t, hook.DisabledUntil.Time.Equal(disabledUntil), "disabled until")
+	assert.Equal(t, desired.ConfigHash(), hook.ObservedConfigHash)
+	assert.Equal(t, 7, hook.OwnerID)
+	assert.Empty(t, hook.PathWithNamespace)
+
+	// the hook was re-enabled, and its project can be retrieved
+	gl.hooks[testProjectURL][id].AlertStatus = "executable"
+	gl.hooks[testProjectURL][id].DisabledUntil = nil
+	delete(gl.failures, "Owner")
+
+	_, err = r.syncWebhook(ctx, src, &hook, &id, url)
+	require.NoError(t, err)
+
+	assert.Equal(t, "executable", hook.AlertStatus)
+	assert.Nil(t, hook.DisabledUntil)
+	assert.Equal(t, 1, hook.OwnerID)
+	assert.Equal(t, testProjectURL, hook.PathWithNamespace)
+}
+
+// newTestWebhookReconciler returns a webhookReconciler for the given fake
+// GitLab instance.
+func newTestWebhookReconciler(gl *fakeGitLab) *webhookReconciler {
+	return &webhookReconciler{
+		gitlabCg:    gl.getter(),
+		clusterID:   testClusterID,
+		credentials: newCredentialsCache(time.Minute),
+	}
+}
+
+func TestReconcileUnsupportedEventTypes(t *testing.T) {
+	src := newTestGitLabSource()
+	src.Spec.EventTypes = []string{v1beta1.GitLabWebhookPush, v1beta1.GitLabWebhookMember}
+	src.Spec.Sink.URI = apis.HTTP("sink.example.com")
+
+	ctx := context.WithValue(context.Background(), addressable.Key{}, &duck.CachedInformerFactory{})
+
+	// neither secrets nor receive adapters can be reconciled by this
+	// reconciler, which must therefore return before
+	r := &Reconciler{
+		sinkResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+	}
+
+	event := r.ReconcileKind(ctx, src)
+	require.Error(t, event)
+	assert.Contains(t, event.Error(), v1beta1.GitLabWebhookMember)
+
+	assert.True(t, src.Status.GetCondition(v1beta1.GitLabSourceConditionSinkProvided).IsTrue(), "sink provided")
+	cond := src.Status.GetCondition(v1beta1.GitLabSourceConditionWebhookConfigured)
+	require.NotNil(t, cond)
+	assert.True(t, cond.IsFalse(), "webhook configured")
+	assert.Equal(t, "UnsupportedEventTypes", cond.Reason)
+	assert.True(t, src.Status.GetCondition(v1beta1.GitLabSourceConditionDeployed).IsUnknown(), "adapter deployed")
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1alpha1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+)
+
+// SystemSourceReconciler reconciles a GitLabSystemSource object
+type SystemSourceReconciler struct {
+	adapterReconciler
+	secretTracker
+
+	gitlabCg gitlab.SystemHookClientGetter
+
+	sinkResolver *resolver.URIResolver
+}
+
+func (r *SystemSourceReconciler) ReconcileKind(ctx context.Context, src *v1alpha1.GitLabSystemSource) reconciler.Event {
+	src.Status.CloudEventAttributes = CreateCloudEventAttributes(src.AsEventSource(), src.EventTypes())
+
+	sinkURI, err := resolveSinkURL(ctx, r.sinkResolver, src, &src.Spec.Sink)
+	if err != nil {
+		src.Status.MarkNoSink()
+		return reconciler.NewEvent(corev1.EventTypeWarning,
+			"BadSinkURI", "Could not resolve sink URI: %s", err)
+	}
+	src.Status.MarkSink(sinkURI)
+
+	unlabeled, err := r.trackSecrets(ctx, src, src.Spec.AccessToken.SecretKeyRef, src.Spec.SecretToken.SecretKeyRef)
+	if err != nil {
+		return err
+	}
+	if len(unlabeled) > 0 {
+		src.Status.MarkSecretsNotWatched("%s", unlabeledSecretsMessage(unlabeled))
+	} else {
+		src.Status.MarkSecretsWatched()
+	}
+
+	adapter, err := r.reconcileAdapter(ctx, &adapterArgs{
+		owner:              src,
+		serviceAccountName: src.Spec.ServiceAccountName,
+		secretToken:        src.Spec.SecretToken.SecretKeyRef,
+		eventSource:        src.AsEventSource(),
+		sinkURI:            src.Status.SinkURI,
+	})
+	if err != nil {
+		src.Status.MarkNotDeployed("FailedSync", "Error reconciling receive adapter: %s", err)
+		return fmt.Errorf("reconciling receive adapter: %w", err)
+	}
+
+	if adapter.outOfDate != "" {
+		src.Status.MarkAdapterOutOfDate(adapter.outOfDate)
+	} else {
+		src.Status.MarkAdapterUpToDate()
+	}
+
+	if !adapter.ready {
+		src.Status.MarkNotDeployed("NotReady", "Receive adapter is not ready")
+		return nil
+	}
+	src.Status.MarkDeployed()
+
+	adapterURL := adapter.url
+
+	// skip this cycle if the adapter's URL couldn't yet be determined
+	if adapterURL == nil {
+		return nil
+	}
+
+	hookID, err := syncSystemHook(ctx, r.gitlabCg, src, adapterURL)
+	if err != nil {
+		return err
+	}
+
+	src.Status.WebhookID = &hookID
+	src.Status.MarkWebhook()
+
+	if adapter.upgradeDeferred {
+		return controller.NewRequeueAfter(upgradeRetryPeriod)
+	}
+
+	return nil
+}
+
+func (r *SystemSourceReconciler) FinalizeKind(ctx context.Context, src *v1alpha1.GitLabSystemSource) reconciler.Event {
+	currentHookID := src.Status.WebhookID
+
+	if currentHookID == nil {
+		return nil
+	}
+
+	gitlabCli, err := r.gitlabCg.Get(src)
+	switch {
+	case isMissingCredentials(err):
+		// the finalizer is unlikely to recover from missing
+		// credentials, so we simply record a warning event and return
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeWarning, "FailedWebhookDelete",
+			"GitLab API token missing while finalizing event source. Ignoring: %s", err)
+		return nil
+
+	case isDenied(err):
+		// it is unlikely that we recover from auth errors in the
+		// finalizer, so we simply record a warning event and return
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeWarning, "FailedWebhookDelete",
+			"Access denied to GitLab API while finalizing event source. Ignoring: %s", err)
+		return nil
+
+	case err != nil:
+		return reconciler.NewEvent(corev1.EventTypeWarning,
+			"ClientError", "Error obtaining GitLab system hook client: %s", err)
+	}
+
+	if err := gitlabCli.Delete(*currentHookID); err != nil && !isHookNotFound(err) {
+		return err
+	}
+
+	src.Status.WebhookID = nil
+
+	return nil
+}
+
+// syncSystemHook reconciles the GitLab instance's system hook with its
+// desired state.
+// System hooks can not be edited, so a hook which differs from the desired
+// state gets replaced with a new one.
+func syncSystemHook(ctx context.Context, cg gitlab.SystemHookClientGetter,
+	src *v1alpha1.GitLabSystemSource, url *apis.URL) (hookID int, err error) {
+
+	cli, err := cg.Get(src)
+	switch {
+	case isMissingCredentials(err):
+		src.Status.MarkNoWebhook(missingCredentialsReason(err), "Error obtaining credentials for GitLab API: %s", err)
+		return -1, reconciler.NewEvent(corev1.EventTypeWarning,
+			"AuthError", "Error obtaining credentials for GitLab API: %s", err)
+
+	case err != nil:
+		src.Status.MarkNoWebhook("ClientError", "Error obtaining GitLab system hook client: %s", err)
+		// wrap reconciler events to fail (and retry) the reconciliation
+		return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
+			"ClientError", "Error obtaining GitLab system hook client: %s", err))
+	}
+
+	desired := &gitlab.Hook{
+		URL:                   url.String(),
+		EventTypes:            src.Spec.EventTypes,
+		EnableSSLVerification: src.Spec.SSLVerify,
+	}
+
+	addHook := func() (int, error) {
+		hookID, err := cli.Add(desired)
+		if err != nil {
+			src.Status.MarkNoWebhook("WebhookError", "Error adding system hook: %s", err)
+			return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
+				"WebhookError", "Error adding system hook: %s", err))
+		}
+
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal,
+			"WebHookCreated", "System hook created successfully")
+
+		return hookID, nil
+	}
+
+	currentHookID := src.Status.WebhookID
+
+	if currentHookID == nil {
+		return addHook()
+	}
+
+	hook, err := cli.Get(*currentHookID)
+	switch {
+	case isHookNotFound(err):
+		return addHook()
+
+	case err != nil:
+		src.Status.MarkNoWebhook("WebhookError", "Error retrieving system hook: %s", err)
+		return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
+			"WebhookError", "Error retrieving system hook: %s", err))
+	}
+
+	if hookMatches(hook, desired) {
+		return *currentHookID, nil
+	}
+
+	if err := cli.Delete(*currentHookID); err != nil && !isHookNotFound(err) {
+		src.Status.MarkNoWebhook("WebhookError", "Error replacing outdated system hook: %s", err)
+		return -1, fmt.Errorf("%w", reconciler.NewEvent(corev1.EventTypeWarning,
+			"WebhookError", "Error replacing outdated system hook: %s", err))
+	}
+
+	return addHook()
+}
+
+// hookMatches returns whether the given GitLab hook matches the desired
+// configuration.
+func hookMatches(hook, desired *gitlab.Hook) bool {
+	if hook.URL != desired.URL || hook.EnableSSLVerification != desired.EnableSSLVerification {
+		return false
+	}
+
+	return sets.NewString(desired.EventTypes...).Equal(sets.NewString(hook.EventTypes...))
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// secretTokenLength is the number of random bytes in generated secret tokens.
+const secretTokenLength = 32
+
+// secretTokenReconciler reconciles the secret tokens of GitLab event sources:
+// the tokens generated for sources which don't reference one, and the
+// rotation of tokens.
+type secretTokenReconciler struct {
+	secretTracker
+
+	// Duration during which the previous secret token is accepted after
+	// the source's hooks were updated with a new one.
+	rotationGracePeriod time.Duration
+
+	// hasher hashes the secret tokens recorded in the status of sources
+	// and in the annotations of receive adapters.
+	hasher *gitlab.SecretTokenHasher
+}
+
+// reconcileSecretToken ensures that a Secret containing a generated secret
+// token exists for sources which don't declare a secret token in their spec,
+// and records its name in the source's status.
+func (r *secretTokenReconciler) reconcileSecretToken(ctx context.Context, src *v1beta1.GitLabSource) error {
+	if src.Spec.SecretToken != nil {
+		// a previously generated Secret may still be referenced by the
+		// receive adapter, so we leave it to the garbage collector
+		src.Status.SecretTokenSecretName = ""
+		return nil
+	}
+
+	if r.readsFiles() {
+		return errors.New("secret tokens are only generated when secrets are read from the Kubernetes API, " +
+			"the spec must reference a secret token")
+	}
+
+	name := kmeta.ChildName(src.Name, "-secret-token")
+
+	secr, err := r.getSecret(ctx, src.Namespace, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		secr, err = newSecretTokenSecret(src, name)
+		if err != nil {
+			return fmt.Errorf("generating secret token: %w", err)
+		}
+
+		if _, err := r.secretCli(src.Namespace).Create(ctx, secr, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating Secret for generated secret token: %w", err)
+		}
+
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal,
+			"SecretTokenCreated", "Generated secret token in Secret %q", name)
+
+	case err != nil:
+		return fmt.Errorf("getting Secret for generated secret token: %w", err)
+
+	case !metav1.IsControlledBy(secr, src):
+		return fmt.Errorf("secret %q already exists and is not owned by the source", name)
+	}
+
+	src.Status.SecretTokenSecretName = name
+
+	return nil
+}
+
+// newSecretTokenSecret returns a Secret owned by the given source, which
+// contains a randomly generated secret token.
+func newSecretTokenSecret(src *v1beta1.GitLabSource, name string) (*corev1.Secret, error) {
+	token := make([]byte, secretTokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: src.Namespace,
+			Labels: map[string]string{
+				"receive-adapter":    "gitlab",
+				secret.WatchLabelKey: "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(src),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			v1beta1.GeneratedSecretTokenKey: []byte(hex.EncodeToString(token)),
+		},
+	}, nil
+}
+
+// secretTokenHashKeySecretName is the name of the Secret, in the controller's
+// namespace, which contains the key of the hashes of secret tokens.
+const secretTokenHashKeySecretName = "gitlab-secret-token-hash-key"
+
+// secretTokenHashKeyRef references the key of the hashes of secret tokens.
+var secretTokenHashKeyRef = &corev1.SecretKeySelector{
+	LocalObjectReference: corev1.LocalObjectReference{Name: secretTokenHashKeySecretName},
+	Key:                  "key",
+}
+
+// ensureSecretTokenHashKey returns the key of the hashes of secret tokens,
+// stored in a Secret which is created with a random key if it doesn't exist
+// yet.
+func ensureSecretTokenHashKey(ctx context.Context, cli coreclientv1.SecretInterface) ([]byte, error) {
+	secr, err := cli.Get(ctx, secretTokenHashKeySecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		key := make([]byte, secretTokenLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("generating key: %w", err)
+		}
+
+		secr, err = cli.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: secretTokenHashKeySecretName,
+			},
+			Data: map[string][]byte{
+				secretTokenHashKeyRef.Key: key,
+			},
+		}, metav1.CreateOptions{})
+
+		// created concurrently by another replica of the controller
+		if apierrors.IsAlreadyExists(err) {
+			secr, err = cli.Get(ctx, secretTokenHashKeySecretName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := secr.Data[secretTokenHashKeyRef.Key]
+	if len(key) == 0 {
+		return nil, fmt.Errorf("secret %q has no %q key", secretTokenHashKeySecretName, secretTokenHashKeyRef.Key)
+	}
+	return key, nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// secretTokenHashAnnotation is set on the Pods of receive adapters to the
+// hash of their secret token, so that adapters are rolled whenever the token
+// changes.
+const secretTokenHashAnnotation = "sources.knative.dev/secret-token-hash"
+
+// reconcileSecretTokenRotation detects changes to the source's secret token,
+// and starts a rotation when the token differs from the one applied to the
+// source's hooks.
+//
+// The controller records the token applied to all of the source's hooks in a
+// Secret owned by the source. During a rotation, the receive adapter accepts
+// both this token and the new one, until the hooks were updated and the
+// grace period elapsed.
+//
+// It returns the source's current secret token, or an empty string if it
+// can't be read, in which case errors are reported while syncing hooks.
+func (r *secretTokenReconciler) reconcileSecretTokenRotation(ctx context.Context, src *v1beta1.GitLabSource) (string, error) {
+	ref := src.SecretTokenRef()
+	if ref == nil {
+		src.Status.SecretTokenRotation = nil
+		return "", nil
+	}
+
+	tokens, err := r.secretGetter(src.Namespace).Get(ref)
+	switch {
+	case isMissingCredentials(err):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("getting secret token: %w", err)
+	}
+	token := tokens[0]
+
+	// the previous token can't be recorded without access to the
+	// Kubernetes API, so tokens are rotated without grace period
+	if r.readsFiles() {
+		src.Status.SecretTokenRotation = nil
+		return token, nil
+	}
+
+	applied, err := r.getSecret(ctx, src.Namespace, appliedSecretTokenName(src))
+	switch {
+	case apierrors.IsNotFound(err):
+		// the hooks never all used the same token, so there is no
+		// previous token to accept
+		src.Status.SecretTokenRotation = nil
+		return token, nil
+
+	case err != nil:
+		return "", fmt.Errorf("getting Secret for applied secret token: %w", err)
+
+	case !metav1.IsControlledBy(applied, src):
+		return "", fmt.Errorf("secret %q already exists and is not owned by the source", applied.Name)
+	}
+
+	if string(applied.Data[v1beta1.GeneratedSecretTokenKey]) == token {
+		src.Status.SecretTokenRotation = nil
+		return token, nil
+	}
+
+	if src.Status.SecretTokenRotation == nil {
+		src.Status.SecretTokenRotation = &v1beta1.SecretTokenRotationStatus{
+			PreviousSecretName: applied.Name,
+		}
+
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "SecretTokenRotationStarted",
+			"Secret token changed, the previous token is accepted until all hooks use the new one")
+	}
+
+	return token, nil
+}
+
+// reconcileAppliedSecretToken records the given secret token once it was
+// applied to all of the source's hooks, and ends the ongoing rotation of the
+// secret token after its grace period.
+//
+// It returns the duration after which the source should be reconciled again
+// to end the rotation, or zero.
+func (r *secretTokenReconciler) reconcileAppliedSecretToken(ctx context.Context,
+	src *v1beta1.GitLabSource, token string) (time.Duration, error) {
+
+	if r.readsFiles() {
+		return 0, nil
+	}
+
+	rotation := src.Status.SecretTokenRotation
+
+	if token == "" || !hooksUseSecretToken(src, r.hasher.Hash(token)) {
+		if rotation != nil {
+			rotation.HooksUpdatedTime = nil
+		}
+		return 0, nil
+	}
+
+	if rotation != nil {
+		if rotation.HooksUpdatedTime == nil {
+			now := metav1.Now()
+			rotation.HooksUpdatedTime = &now
+		}
+		if remaining := time.Until(rotation.HooksUpdatedTime.Add(r.rotationGracePeriod)); remaining > 0 {
+			return remaining, nil
+		}
+	}
+
+	name := appliedSecretTokenName(src)
+
+	applied, err := r.getSecret(ctx, src.Namespace, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		desired := newAppliedSecretTokenSecret(src, name, token)
+		if _, err := r.secretCli(src.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return 0, fmt.Errorf("creating Secret for applied secret token: %w", err)
+		}
+
+	case err != nil:
+		return 0, fmt.Errorf("getting Secret for applied secret token: %w", err)
+
+	case !metav1.IsControlledBy(applied, src):
+		return 0, fmt.Errorf("secret %q already exists and is not owned by the source", name)
+
+	case string(applied.Data[v1beta1.GeneratedSecretTokenKey]) != token:
+		updated := applied.DeepCopy()
+		updated.Data = map[string][]byte{
+			v1beta1.GeneratedSecretTokenKey: []byte(token),
+		}
+		if _, err := r.secretCli(src.Namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return 0, fmt.Errorf("updating Secret for applied secret token: %w", err)
+		}
+	}
+
+	if rotation != nil {
+		src.Status.SecretTokenRotation = nil
+
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, "SecretTokenRotationCompleted",
+			"All hooks use the new secret token, the previous token is no longer accepted")
+	}
+
+	return 0, nil
+}
+
+// hooksUseSecretToken returns whether the secret token with the given hash was
+// applied to all of the source's hooks.
+func hooksUseSecretToken(src *v1beta1.GitLabSource, tokenHash string) bool {
+	for _, hook := range src.Status.Webhooks {
+		if hook.SecretTokenHash != tokenHash {
+			return false
+		}
+	}
+	return true
+}
+
+// appliedSecretTokenName returns the name of the Secret in which the secret
+// token applied to the source's hooks is recorded.
+func appliedSecretTokenName(src *v1beta1.GitLabSource) string {
+	return kmeta.ChildName(src.Name, "-applied-secret-token")
+}
+
+// newAppliedSecretTokenSecret returns a Secret owned by the given source,
+// which contains the secret token applied to the source's hooks.
+func newAppliedSecretTokenSecret(src *v1beta1.GitLabSource, name, token string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: src.Namespace,
+			Labels: map[string]string{
+				"receive-adapter":    "gitlab",
+				secret.WatchLabelKey: "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(src),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			v1beta1.GeneratedSecretTokenKey: []byte(token),
+		},
+	}
+}
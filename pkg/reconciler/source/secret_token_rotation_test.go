@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/pkg/controller"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+)
+
+func TestReconcileSecretTokenRotation(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Secret token applied to all hooks, if recorded.
+		applied string
+
+		expectRotation bool
+	}{
+		{
+			name: "Token was never applied to all hooks",
+		},
+		{
+			name:    "Token is applied to all hooks",
+			applied: "current-token",
+		},
+		{
+			name:           "Token changed",
+			applied:        "previous-token",
+			expectRotation: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := newTestRotatingGitLabSource()
+
+			objs := []runtime.Object{newTestUserSecretToken("current-token")}
+			if tc.applied != "" {
+				objs = append(objs, newAppliedSecretTokenSecret(src, appliedSecretTokenName(src), tc.applied))
+			}
+			r := newTestSecretTokenReconciler(t, objs...)
+
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+			token, err := r.reconcileSecretTokenRotation(ctx, src)
+			require.NoError(t, err)
+			assert.Equal(t, "current-token", token)
+
+			if !tc.expectRotation {
+				assert.Nil(t, src.Status.SecretTokenRotation, "rotation")
+				assert.Empty(t, recorder.Events, "events")
+				return
+			}
+			require.NotNil(t, src.Status.SecretTokenRotation, "rotation")
+			assert.Equal(t, appliedSecretTokenName(src), src.Status.SecretTokenRotation.PreviousSecretName)
+			require.Len(t, recorder.Events, 1)
+			assert.Contains(t, <-recorder.Events, "SecretTokenRotationStarted")
+		})
+	}
+}
+
+// TestSecretTokenRotationLifecycle follows the rotation of a secret token,
+// from the change of the token to the end of the grace period.
+func TestSecretTokenRotationLifecycle(t *testing.T) {
+	const gracePeriod = time.Hour
+
+	src := newTestRotatingGitLabSource()
+	src.Status.Webhooks = []v1beta1.WebhookStatus{{
+		ProjectURL:      testProjectURL,
+		SecretTokenHash: testSecretTokenHasher.Hash("previous-token"),
+	}}
+
+	kc := fake.NewSimpleClientset(
+		newTestUserSecretToken("current-token"),
+		newAppliedSecretTokenSecret(src, appliedSecretTokenName(src), "previous-token"),
+	)
+	r := newTestSecretTokenReconciler(t)
+	r.secretCli = kc.CoreV1().Secrets
+	r.rotationGracePeriod = gracePeriod
+
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	appliedToken := func() string {
+		secr, err := kc.CoreV1().Secrets(testNamespace).Get(ctx, appliedSecretTokenName(src), metav1.GetOptions{})
+		require.NoError(t, err)
+		return string(secr.Data[v1beta1.GeneratedSecretTokenKey])
+	}
+
+	token, err := r.reconcileSecretTokenRotation(ctx, src)
+	require.NoError(t, err)
+	require.NotNil(t, src.Status.SecretTokenRotation, "rotation started")
+
+	// the hooks still use the previous token
+	requeue, err := r.reconcileAppliedSecretToken(ctx, src, token)
+	require.NoError(t, err)
+	assert.Zero(t, requeue)
+	assert.Nil(t, src.Status.SecretTokenRotation.HooksUpdatedTime, "time of the update of the hooks")
+	assert.Equal(t, "previous-token", appliedToken())
+
+	// the hooks were updated, the previous token remains accepted during
+	// the grace period
+	src.Status.Webhooks[0].SecretTokenHash = testSecretTokenHasher.Hash(token)
+
+	requeue, err = r.reconcileAppliedSecretToken(ctx, src, token)
+	require.NoError(t, err)
+	assert.InDelta(t, gracePeriod, requeue, float64(time.Minute))
+	require.NotNil(t, src.Status.SecretTokenRotation, "rotation")
+	assert.NotNil(t, src.Status.SecretTokenRotation.HooksUpdatedTime, "time of the update of the hooks")
+	assert.Equal(t, "previous-token", appliedToken())
+
+	// the grace period elapsed
+	src.Status.SecretTokenRotation.HooksUpdatedTime = &metav1.Time{Time: time.Now().Add(-2 * gracePeriod)}
+
+	requeue, err = r.reconcileAppliedSecretToken(ctx, src, token)
+	require.NoError(t, err)
+	assert.Zero(t, requeue)
+	assert.Nil(t, src.Status.SecretTokenRotation, "rotation ended")
+	assert.Equal(t, "current-token", appliedToken())
+}
+
+func TestReconcileAppliedSecretTokenFirstApplication(t *testing.T) {
+	src := newTestRotatingGitLabSource()
+	src.Status.Webhooks = []v1beta1.WebhookStatus{{
+		ProjectURL:      testProjectURL,
+		SecretTokenHash: testSecretTokenHasher.Hash("current-token"),
+	}}
+
+	kc := fake.NewSimpleClientset()
+	r := newTestSecretTokenReconciler(t)
+	r.secretCli = kc.CoreV1().Secrets
+
+	ctx := controller.WithEventRecorder(context.Background(), record.NewFakeRecorder(10))
+
+	_, err := r.reconcileAppliedSecretToken(ctx, src, "current-token")
+	require.NoError(t, err)
+
+	applied, err := kc.CoreV1().Secrets(testNamespace).Get(ctx, appliedSecretTokenName(src), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(applied, src), "Secret is owned by the source")
+	assert.Equal(t, "current-token", string(applied.Data[v1beta1.GeneratedSecretTokenKey]))
+}
+
+// newTestSecretTokenReconciler returns a secretTokenReconciler whose cache
+// and API contain the given Secrets.
+func newTestSecretTokenReconciler(t *testing.T, objs ...runtime.Object) *secretTokenReconciler {
+	st := newTestSecretTracker(t, fake.NewSimpleClientset(objs...), objs...)
+
+	return &secretTokenReconciler{
+		secretTracker:       *st,
+		rotationGracePeriod: time.Minute,
+		hasher:              testSecretTokenHasher,
+	}
+}
+
+// newTestRotatingGitLabSource returns a source which references the Secret
+// returned by newTestUserSecretToken.
+func newTestRotatingGitLabSource() *v1beta1.GitLabSource {
+	src := newTestGitLabSource()
+	src.Spec.SecretToken = &v1beta1.SecretValueFromSource{SecretKeyRef: secretKeyRef("user-secret-token")}
+	return src
+}
+
+// newTestUserSecretToken returns a Secret created by users, which contains the
+// given secret token.
+func newTestUserSecretToken(token string) *corev1.Secret {
+	secr := newTestSecret("user-secret-token", true)
+	secr.Data = map[string][]byte{"token": []byte(token)}
+	return secr
+}
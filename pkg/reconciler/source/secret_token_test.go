@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnsureSecretTokenHashKey(t *testing.T) {
+	ctx := context.Background()
+	cli := fake.NewSimpleClientset().CoreV1().Secrets("knative-sources")
+
+	key, err := ensureSecretTokenHashKey(ctx, cli)
+	require.NoError(t, err)
+	assert.Len(t, key, secretTokenLength)
+
+	// the key remains the same across restarts of the controller
+	again, err := ensureSecretTokenHashKey(ctx, cli)
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+}
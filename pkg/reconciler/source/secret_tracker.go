@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/tracker"
+
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+// secretTracker gives access to the Secrets referenced by event sources
+// through the controller's cache, and notifies sources of changes to these
+// Secrets.
+//
+// The cache is restricted to Secrets which carry the secret.WatchLabelKey
+// label, which users set on the Secrets they reference from sources. Secrets
+// missing from the cache are read from the Kubernetes API, but changes to
+// them aren't noticed.
+//
+// When secrets are read from files instead, neither the cache nor the tracker
+// are used.
+type secretTracker struct {
+	secretCli    func(namespace string) coreclientv1.SecretInterface
+	secretLister corelistersv1.SecretLister
+
+	// tracker notifies sources of changes to the Secrets they reference.
+	tracker tracker.Interface
+
+	// Directory containing the secrets, when they are read from files.
+	secretDir string
+}
+
+// readsFiles returns whether secrets are read from files instead of the
+// Kubernetes API.
+func (t *secretTracker) readsFiles() bool {
+	return t.secretDir != ""
+}
+
+// secretGetter returns a Getter for the Secrets of the given namespace.
+func (t *secretTracker) secretGetter(namespace string) secret.Getter {
+	if t.readsFiles() {
+		return secret.NewFileGetter(t.secretDir, namespace)
+	}
+	return secret.NewListerGetter(t.secretLister.Secrets(namespace), t.secretCli(namespace))
+}
+
+// getSecret returns the Secret with the given name, from the controller's
+// cache if possible.
+func (t *secretTracker) getSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
+	secr, err := t.secretLister.Secrets(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return t.secretCli(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	return secr, err
+}
+
+// trackSecrets notifies the given source of changes to the Secrets referenced
+// by the given selectors, and returns the names of the referenced Secrets
+// which lack the secret.WatchLabelKey label, and whose changes are therefore
+// not noticed.
+// Referenced Secrets are never modified, since they belong to users.
+func (t *secretTracker) trackSecrets(ctx context.Context, src kmeta.Accessor,
+	refs ...*corev1.SecretKeySelector) (unlabeled []string, err error) {
+
+	if t.readsFiles() {
+		return nil, nil
+	}
+
+	tracked := make(map[string]struct{}, len(refs))
+
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		if _, isTracked := tracked[ref.Name]; isTracked {
+			continue
+		}
+		tracked[ref.Name] = struct{}{}
+
+		err := t.tracker.TrackReference(tracker.Reference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Namespace:  src.GetNamespace(),
+			Name:       ref.Name,
+		}, src)
+		if err != nil {
+			return nil, fmt.Errorf("tracking Secret %q: %w", ref.Name, err)
+		}
+
+		if _, err := t.secretLister.Secrets(src.GetNamespace()).Get(ref.Name); !apierrors.IsNotFound(err) {
+			continue
+		}
+
+		// the Secret may be missing from the cache only because it was
+		// just created, or not exist at all, which is reported when its
+		// value is read
+		secr, err := t.secretCli(src.GetNamespace()).Get(ctx, ref.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			logging.FromContext(ctx).Warnw("Failed to retrieve Secret referenced by the source",
+				zap.String("secret", ref.Name), zap.Error(err))
+			continue
+		}
+
+		if secr.Labels[secret.WatchLabelKey] != "true" {
+			unlabeled = append(unlabeled, ref.Name)
+		}
+	}
+
+	return unlabeled, nil
+}
+
+// unlabeledSecretsMessage returns the message of the SecretsWatched condition
+// of sources which reference the given unlabeled Secrets.
+func unlabeledSecretsMessage(unlabeled []string) string {
+	return fmt.Sprintf("Changes to the Secrets %s are not detected until they carry the label %s",
+		strings.Join(unlabeled, ", "), secret.WatchLabelSelector)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/tracker"
+
+	"knative.dev/eventing-gitlab/pkg/secret"
+)
+
+func TestTrackSecrets(t *testing.T) {
+	src := newTestGitLabSource()
+
+	labeled := newTestSecret("labeled", true)
+	unlabeled := newTestSecret("unlabeled", false)
+
+	kc := fake.NewSimpleClientset(labeled, unlabeled)
+	st := newTestSecretTracker(t, kc, labeled)
+
+	got, err := st.trackSecrets(context.Background(), src,
+		secretKeyRef("labeled"), secretKeyRef("unlabeled"), secretKeyRef("unlabeled"), secretKeyRef("missing"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unlabeled"}, got, "unlabeled Secrets")
+
+	for _, action := range kc.Actions() {
+		assert.Equal(t, "get", action.GetVerb(), "Secrets must not be modified")
+	}
+}
+
+// newTestSecretTracker returns a secretTracker whose cache contains the given
+// Secrets.
+func newTestSecretTracker(t *testing.T, kc *fake.Clientset, cached ...runtime.Object) *secretTracker {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, obj := range cached {
+		require.NoError(t, indexer.Add(obj))
+	}
+
+	return &secretTracker{
+		secretCli:    kc.CoreV1().Secrets,
+		secretLister: corelistersv1.NewSecretLister(indexer),
+		tracker:      tracker.New(func(types.NamespacedName) {}, time.Minute),
+	}
+}
+
+func newTestSecret(name string, labeled bool) *corev1.Secret {
+	secr := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      name,
+		},
+	}
+	if labeled {
+		secr.Labels = map[string]string{secret.WatchLabelKey: "true"}
+	}
+	return secr
+}
+
+func secretKeyRef(name string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  "token",
+	}
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/clientset/versioned/scheme"
+	clientv1beta1 "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/typed/sources/v1beta1"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+	listersv1beta1 "knative.dev/eventing-gitlab/pkg/client/listers/sources/v1beta1"
+)
+
+// webhookGCPolicy determines how orphaned hooks are handled by the webhook
+// sweeper.
+type webhookGCPolicy string
+
+// Supported webhook garbage collection policies.
+const (
+	// Orphaned hooks are deleted from GitLab.
+	webhookGCPolicyDelete webhookGCPolicy = "Delete"
+	// Orphaned hooks are reported through events, but left in GitLab.
+	webhookGCPolicyReport webhookGCPolicy = "Report"
+	// The webhook sweeper doesn't run.
+	webhookGCPolicyDisabled webhookGCPolicy = "Disabled"
+)
+
+// webhookSweeperName is the name of the key whose bucket determines which
+// replica of the controller runs the webhook sweeper.
+const webhookSweeperName = "gitlab-webhook-gc"
+
+// webhookSweeper periodically looks for orphaned hooks, which were registered
+// by the controller for GitLabSources that no longer exist, in the GitLab
+// projects and groups referenced by existing GitLabSources.
+//
+// Such hooks are left behind when a source is deleted without its finalizer
+// being able to remove its hooks, e.g. because its credentials were revoked.
+//
+// Only the leader of the bucket of the sweeper's key runs the sweeper, so that
+// replicas of the controller don't sweep the same hooks concurrently.
+type webhookSweeper struct {
+	policy   webhookGCPolicy
+	interval time.Duration
+
+	lister    listersv1beta1.GitLabSourceLister
+	hasSynced cache.InformerSynced
+	sourceCli func(namespace string) clientv1beta1.GitLabSourceInterface
+	cg        gitlab.WebhookClientGetter
+	recorder  record.EventRecorder
+
+	// Identifier of the cluster. Hooks registered from other clusters are
+	// ignored.
+	clusterID string
+
+	mu sync.Mutex
+	// Stops the sweeps of the current leadership term, nil while the
+	// controller doesn't lead the bucket of the sweeper's key.
+	stop context.CancelFunc
+}
+
+// key returns the key whose bucket determines which replica of the controller
+// runs the sweeper.
+func (s *webhookSweeper) key() types.NamespacedName {
+	return types.NamespacedName{Namespace: system.Namespace(), Name: webhookSweeperName}
+}
+
+// promote starts the sweeper when the controller is promoted to the leader of
+// the bucket of the sweeper's key.
+func (s *webhookSweeper) promote(ctx context.Context, bkt reconciler.Bucket) {
+	if s.policy == webhookGCPolicyDisabled || !bkt.Has(s.key()) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+
+	ctx, s.stop = context.WithCancel(ctx)
+	go s.run(ctx)
+}
+
+// demote stops the sweeper when the controller loses the leadership of the
+// bucket of the sweeper's key.
+func (s *webhookSweeper) demote(bkt reconciler.Bucket) {
+	if !bkt.Has(s.key()) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop != nil {
+		s.stop()
+		s.stop = nil
+	}
+}
+
+// run sweeps orphaned hooks at the sweeper's interval until the context is
+// cancelled. The first sweep occurs once the lister's cache is synced, so
+// that existing sources are never mistaken for deleted ones.
+func (s *webhookSweeper) run(ctx context.Context) {
+	if !cache.WaitForCacheSync(ctx.Done(), s.hasSynced) {
+		return
+	}
+
+	wait.UntilWithContext(ctx, s.sweep, s.interval)
+}
+
+// sweep handles the orphaned hooks of all GitLab projects and groups
+// referenced by existing sources.
+func (s *webhookSweeper) sweep(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+
+	srcs, err := s.lister.List(labels.Everything())
+	if err != nil {
+		logger.Errorw("Failed to list GitLab sources for webhook garbage collection", zap.Error(err))
+		return
+	}
+
+	liveSources := make(map[string]struct{}, len(srcs))
+
+	var targets []string
+	sourcesByTarget := make(map[string][]*v1beta1.GitLabSource)
+
+	for _, src := range srcs {
+		liveSources[src.Namespace+"/"+src.Name] = struct{}{}
+
+		for _, target := range src.WebhookTargets() {
+			if _, isKnown := sourcesByTarget[target]; !isKnown {
+				targets = append(targets, target)
+			}
+			sourcesByTarget[target] = append(sourcesByTarget[target], src)
+		}
+	}
+
+	for _, target := range targets {
+		s.sweepTarget(ctx, target, sourcesByTarget[target], liveSources)
+	}
+}
+
+// sweepTarget handles the orphaned hooks of a single GitLab project or group,
+// using the credentials of the first of the given sources which are usable.
+// Orphaned hooks are reported on that source.
+func (s *webhookSweeper) sweepTarget(ctx context.Context, target string,
+	srcs []*v1beta1.GitLabSource, liveSources map[string]struct{}) {
+
+	logger := logging.FromContext(ctx).With(zap.String("target", target))
+
+	var src *v1beta1.GitLabSource
+	var cli gitlab.WebhookClient
+	var err error
+
+	for _, src = range srcs {
+		hook := src.NewWebhookStatus(target, 0)
+		if cli, err = s.cg.Get(src, &hook); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		logger.Warnw("Failed to obtain GitLab webhook client for webhook garbage collection", zap.Error(err))
+		return
+	}
+
+	hooks, err := cli.List()
+	if err != nil {
+		logger.Warnw("Failed to list webhooks for webhook garbage collection", zap.Error(err))
+		return
+	}
+
+	for _, h := range hooks {
+		owner, clusterID, isManaged := webhookOwner(h)
+		if !isManaged || (clusterID != "" && clusterID != s.clusterID) {
+			continue
+		}
+		if _, isLive := liveSources[owner]; isLive {
+			continue
+		}
+
+		// sources are listed before their hooks, so hooks added since
+		// then may belong to sources which are missing from the list
+		if h.CreatedAt != nil && time.Since(*h.CreatedAt) < s.interval {
+			continue
+		}
+
+		// the cache of sources may lag behind the API
+		if exists, err := s.sourceExists(ctx, owner); err != nil || exists {
+			if err != nil {
+				logger.Warnw("Failed to get source of webhook", zap.Int("id", h.ID), zap.Error(err))
+			}
+			continue
+		}
+
+		// hooks registered by previous versions of the controller may
+		// belong to sources of other clusters, so they are only reported
+		if s.policy != webhookGCPolicyDelete || clusterID == "" {
+			s.recorder.Eventf(src, corev1.EventTypeWarning, "OrphanedWebhook",
+				"Webhook %d of deleted source %s found in %s", h.ID, owner, target)
+			continue
+		}
+
+		if err := cli.Delete(h.ID); err != nil && !isHookNotFound(err) {
+			logger.Warnw("Failed to delete orphaned webhook", zap.Int("id", h.ID), zap.Error(err))
+			continue
+		}
+
+		s.recorder.Eventf(src, corev1.EventTypeNormal, "OrphanedWebhookDeleted",
+			"Deleted webhook %d of deleted source %s from %s", h.ID, owner, target)
+	}
+}
+
+// sourceExists returns whether the source with the given namespaced name
+// exists, according to the Kubernetes API.
+func (s *webhookSweeper) sourceExists(ctx context.Context, owner string) (bool, error) {
+	ns, name, err := cache.SplitMetaNamespaceKey(owner)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = s.sourceCli(ns).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+// webhookOwner returns the namespaced name of the source which the given hook
+// was registered for and the identifier of the source's cluster, based on the
+// hook's marker, and whether the hook was registered by the controller at all.
+// The identifier of the cluster is empty for hooks registered by previous
+// versions of the controller.
+func webhookOwner(h *gitlab.Hook) (owner, clusterID string, isManaged bool) {
+	owner = strings.TrimPrefix(h.Description, webhookMarkerPrefix)
+	if owner == h.Description || owner == "" {
+		return "", "", false
+	}
+
+	if o, id, hasCluster := strings.Cut(owner, webhookMarkerClusterInfix); hasCluster {
+		owner, clusterID = o, strings.TrimSuffix(id, ")")
+	}
+	return owner, clusterID, true
+}
+
+// newEventRecorder returns an EventRecorder which records events on behalf of
+// the given component.
+func newEventRecorder(ctx context.Context, component string) record.EventRecorder {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(
+		&typedcorev1.EventSinkImpl{Interface: kubeclient.Get(ctx).CoreV1().Events("")})
+
+	go func() {
+		<-ctx.Done()
+		eventBroadcaster.Shutdown()
+	}()
+
+	return eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component})
+}
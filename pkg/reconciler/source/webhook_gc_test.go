@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing-gitlab/pkg/apis/sources/v1beta1"
+	fakeclientset "knative.dev/eventing-gitlab/pkg/client/clientset/versioned/fake"
+	"knative.dev/eventing-gitlab/pkg/client/gitlab"
+	listersv1beta1 "knative.dev/eventing-gitlab/pkg/client/listers/sources/v1beta1"
+)
+
+const testClusterID = "cluster-a"
+
+func TestWebhookSweeperSweep(t *testing.T) {
+	live := newTestGitLabSource()
+	live.Name = "live"
+
+	// exists in the API, but not yet in the controller's cache
+	uncached := newTestGitLabSource()
+	uncached.Name = "uncached"
+
+	old := time.Now().Add(-2 * time.Hour)
+	justNow := time.Now().Add(-time.Minute)
+
+	orphan := func(name, clusterID string, createdAt time.Time) *gitlab.Hook {
+		src := newTestGitLabSource()
+		src.Name = name
+		desc := webhookMarker(src, clusterID)
+		if clusterID == "" {
+			desc = webhookMarkerPrefix + src.Namespace + "/" + src.Name
+		}
+		return &gitlab.Hook{Description: desc, CreatedAt: &createdAt}
+	}
+
+	testCases := []struct {
+		name         string
+		policy       webhookGCPolicy
+		hook         *gitlab.Hook
+		expectExists bool
+		expectEvent  string
+	}{
+		{
+			name:        "Orphaned hook is deleted",
+			policy:      webhookGCPolicyDelete,
+			hook:        orphan("deleted", testClusterID, old),
+			expectEvent: "Normal OrphanedWebhookDeleted",
+		},
+		{
+			name:         "Orphaned hook is reported",
+			policy:       webhookGCPolicyReport,
+			hook:         orphan("deleted", testClusterID, old),
+			expectExists: true,
+			expectEvent:  "Warning OrphanedWebhook",
+		},
+		{
+			name:         "Hook of live source is kept",
+			policy:       webhookGCPolicyDelete,
+			hook:         orphan("live", testClusterID, old),
+			expectExists: true,
+		},
+		{
+			name:         "Hook added after the sources were listed is kept",
+			policy:       webhookGCPolicyDelete,
+			hook:         orphan("deleted", testClusterID, justNow),
+			expectExists: true,
+		},
+		{
+			name:         "Hook of source missing from the cache is kept",
+			policy:       webhookGCPolicyDelete,
+			hook:         orphan("uncached", testClusterID, old),
+			expectExists: true,
+		},
+		{
+			name:         "Hook of other cluster is ignored",
+			policy:       webhookGCPolicyDelete,
+			hook:         orphan("deleted", "cluster-b", old),
+			expectExists: true,
+		},
+		{
+			name:         "Hook without cluster is only reported",
+			policy:       webhookGCPolicyDelete,
+			hook:         orphan("deleted", "", old),
+			expectExists: true,
+			expectEvent:  "Warning OrphanedWebhook",
+		},
+		{
+			name:         "Unmanaged hook is ignored",
+			policy:       webhookGCPolicyDelete,
+			hook:         &gitlab.Hook{Description: "Something else", CreatedAt: &old},
+			expectExists: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gl := newFakeGitLab()
+			hookID := gl.addHook(testProjectURL, tc.hook)
+
+			recorder := record.NewFakeRecorder(10)
+			s := newTestWebhookSweeper(t, gl, recorder, tc.policy,
+				[]*v1beta1.GitLabSource{live}, []*v1beta1.GitLabSource{live, uncached})
+
+			s.sweep(context.Background())
+
+			_, exists := gl.hooks[testProjectURL][hookID]
+			assert.Equal(t, tc.expectExists, exists, "hook exists")
+
+			var events []string
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			if tc.expectEvent == "" {
+				assert.Empty(t, events)
+			} else {
+				require.Len(t, events, 1)
+				assert.Contains(t, events[0], tc.expectEvent)
+			}
+		})
+	}
+}
+
+func TestWebhookOwner(t *testing.T) {
+	src := newTestGitLabSource()
+
+	owner, clusterID, isManaged := webhookOwner(&gitlab.Hook{Description: webhookMarker(src, testClusterID)})
+	assert.True(t, isManaged)
+	assert.Equal(t, testNamespace+"/source", owner)
+	assert.Equal(t, testClusterID, clusterID)
+
+	owner, clusterID, isManaged = webhookOwner(&gitlab.Hook{Description: webhookMarkerPrefix + testNamespace + "/source"})
+	assert.True(t, isManaged)
+	assert.Equal(t, testNamespace+"/source", owner)
+	assert.Empty(t, clusterID)
+
+	_, _, isManaged = webhookOwner(&gitlab.Hook{Description: "Something else"})
+	assert.False(t, isManaged)
+}
+
+func TestWebhookSweeperLeadership(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-sources")
+
+	src := newTestGitLabSource()
+	src.Name = "deleted"
+	old := time.Now().Add(-2 * time.Hour)
+
+	gl := newFakeGitLab()
+	gl.addHook(testProjectURL, &gitlab.Hook{Description: webhookMarker(src, testClusterID), CreatedAt: &old})
+
+	live := newTestGitLabSource()
+	live.Name = "live"
+
+	recorder := record.NewFakeRecorder(10)
+	s := newTestWebhookSweeper(t, gl, recorder, webhookGCPolicyReport,
+		[]*v1beta1.GitLabSource{live}, []*v1beta1.GitLabSource{live})
+	s.hasSynced = func() bool { return true }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// buckets which don't contain the sweeper's key don't start it
+	s.promote(ctx, testBucket{})
+	assert.Nil(t, s.stop, "sweeper started")
+
+	s.promote(ctx, testBucket{hasKey: true})
+	require.NotNil(t, s.stop, "sweeper started")
+	select {
+	case event := <-recorder.Events:
+		assert.Contains(t, event, "Warning OrphanedWebhook")
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("Timed out waiting for the first sweep")
+	}
+
+	// the sweeper keeps running when the controller loses the leadership
+	// of other buckets
+	s.demote(testBucket{})
+	assert.NotNil(t, s.stop, "sweeper stopped")
+
+	s.demote(testBucket{hasKey: true})
+	assert.Nil(t, s.stop, "sweeper stopped")
+
+	s.policy = webhookGCPolicyDisabled
+	s.promote(ctx, testBucket{hasKey: true})
+	assert.Nil(t, s.stop, "disabled sweeper started")
+}
+
+// testBucket is a reconciler.Bucket which either contains all keys or none.
+type testBucket struct {
+	hasKey bool
+}
+
+var _ reconciler.Bucket = testBucket{}
+
+func (b testBucket) Name() string {
+	return "test-bucket"
+}
+
+func (b testBucket) Has(types.NamespacedName) bool {
+	return b.hasKey
+}
+
+// newTestWebhookSweeper returns a webhookSweeper for the given fake GitLab
+// instance, whose cache contains the given cached sources, and whose API
+// contains the given sources.
+func newTestWebhookSweeper(t *testing.T, gl *fakeGitLab, recorder record.EventRecorder, policy webhookGCPolicy,
+	cached, stored []*v1beta1.GitLabSource) *webhookSweeper {
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, src := range cached {
+		require.NoError(t, indexer.Add(src))
+	}
+
+	objs := make([]runtime.Object, 0, len(stored))
+	for _, src := range stored {
+		objs = append(objs, src)
+	}
+
+	return &webhookSweeper{
+		policy:    policy,
+		interval:  time.Hour,
+		lister:    listersv1beta1.NewGitLabSourceLister(indexer),
+		sourceCli: fakeclientset.NewSimpleClientset(objs...).SourcesV1beta1().GitLabSources,
+		cg:        gl.getter(),
+		recorder:  recorder,
+		clusterID: testClusterID,
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var (
+	// ErrSecretNotFound indicates that a referenced Secret doesn't exist.
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrKeyNotFound indicates that a referenced Secret doesn't contain
+	// the referenced key.
+	ErrKeyNotFound = errors.New("key not found in secret")
+	// ErrEmptyValue indicates that the referenced key of a Secret has an
+	// empty value.
+	ErrEmptyValue = errors.New("secret value is empty")
+)
+
+// Error is returned by Getters when the value referenced by a
+// SecretKeySelector can't be obtained. It wraps one of ErrSecretNotFound,
+// ErrKeyNotFound or ErrEmptyValue.
+type Error struct {
+	// Name of the referenced Secret.
+	Name string
+	// Referenced key of the Secret.
+	Key string
+
+	Err error
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("secret %q, key %q: %s", e.Name, e.Key, e.Err)
+}
+
+// Unwrap returns the reason of the error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// newError returns an Error for the given selector.
+func newError(ref *corev1.SecretKeySelector, err error) *Error {
+	return &Error{
+		Name: ref.Name,
+		Key:  ref.Key,
+		Err:  err,
+	}
+}
+
+// keyValue returns the value of the key of the given Secret referenced by the
+// given selector.
+func keyValue(secr *corev1.Secret, ref *corev1.SecretKeySelector) (string, error) {
+	val, ok := secr.Data[ref.Key]
+	if !ok {
+		return "", newError(ref, ErrKeyNotFound)
+	}
+	if len(val) == 0 {
+		return "", newError(ref, ErrEmptyValue)
+	}
+	return string(val), nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NewFileGetter returns a Getter which reads the secrets of the given
+// namespace from files in the given directory.
+func NewFileGetter(dir, namespace string) *GetterWithFiles {
+	return &GetterWithFiles{
+		dir: filepath.Join(dir, namespace),
+	}
+}
+
+// GetterWithFiles gets secrets from files, such as the ones mounted by the
+// CSI driver of a secret store. The value of the key of a Secret is read from
+// the file <dir>/<namespace>/<name>/<key>, without its trailing newline.
+type GetterWithFiles struct {
+	dir string
+}
+
+// GetterWithFiles implements Getter.
+var _ Getter = (*GetterWithFiles)(nil)
+
+// Get implements Getter.
+func (g *GetterWithFiles) Get(refs ...*corev1.SecretKeySelector) (Secrets, error) {
+	s := make(Secrets, 0, len(refs))
+
+	for _, ref := range refs {
+		var val string
+
+		if ref != nil {
+			var err error
+			if val, err = g.readFile(ref); err != nil {
+				return nil, err
+			}
+		}
+
+		s = append(s, val)
+	}
+
+	return s, nil
+}
+
+// readFile returns the value referenced by the given selector.
+func (g *GetterWithFiles) readFile(ref *corev1.SecretKeySelector) (string, error) {
+	// names and keys of Secrets can't traverse directories, but selectors
+	// aren't necessarily validated
+	if !isFileName(ref.Name) {
+		return "", newError(ref, ErrSecretNotFound)
+	}
+	if !isFileName(ref.Key) {
+		return "", newError(ref, ErrKeyNotFound)
+	}
+
+	secretDir := filepath.Join(g.dir, ref.Name)
+
+	if _, err := os.Stat(secretDir); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", newError(ref, ErrSecretNotFound)
+		}
+		return "", fmt.Errorf("reading secret %q: %w", ref.Name, err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(secretDir, ref.Key))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return "", newError(ref, ErrKeyNotFound)
+	case err != nil:
+		return "", fmt.Errorf("reading key %q of secret %q: %w", ref.Key, ref.Name, err)
+	}
+
+	val := strings.TrimSuffix(string(data), "\n")
+	if val == "" {
+		return "", newError(ref, ErrEmptyValue)
+	}
+
+	return val, nil
+}
+
+// isFileName returns whether the given string is a valid name for a file
+// within a directory.
+func isFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsRune(name, filepath.Separator)
+}
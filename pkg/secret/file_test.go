@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestFileGetter(t *testing.T) {
+	const ns = "fake-namespace"
+
+	dir := t.TempDir()
+
+	secretDir := filepath.Join(dir, ns, "secret")
+	require.NoError(t, os.MkdirAll(secretDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(secretDir, "key"), []byte("value\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(secretDir, "empty"), nil, 0o600))
+
+	sg := NewFileGetter(dir, ns)
+
+	ref := func(name, key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  key,
+		}
+	}
+
+	output, err := sg.Get(ref("secret", "key"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, Secrets{"value", ""}, output)
+
+	testCases := map[string]struct {
+		ref     *corev1.SecretKeySelector
+		wantErr error
+	}{
+		"missing Secret": {
+			ref:     ref("missing", "key"),
+			wantErr: ErrSecretNotFound,
+		},
+		"Secret of another namespace": {
+			ref:     ref("../"+ns+"/secret", "key"),
+			wantErr: ErrSecretNotFound,
+		},
+		"missing key": {
+			ref:     ref("secret", "typo"),
+			wantErr: ErrKeyNotFound,
+		},
+		"empty value": {
+			ref:     ref("secret", "empty"),
+			wantErr: ErrEmptyValue,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, err := sg.Get(tc.ref)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -23,16 +23,32 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// WatchLabelKey is the label of the Secrets referenced by GitLab event
+	// sources, which restricts the Secrets cached by the controller and
+	// the shared receive adapter.
+	WatchLabelKey = "sources.knative.dev/gitlab-secret"
+
+	// WatchLabelSelector selects the Secrets referenced by GitLab event
+	// sources.
+	WatchLabelSelector = WatchLabelKey + "=true"
 )
 
 // Secrets is list of secret values.
 type Secrets []string
 
-// Getter can obtain secrets.
+// Getter can obtain secrets. Implementations read secrets from different
+// backends, such as the Kubernetes API or files.
 type Getter interface {
-	// Get returns exactly one secret value per input.
+	// Get returns exactly one secret value per input. Nil inputs yield
+	// empty values. Values which can't be obtained because their Secret,
+	// key or value is missing yield an *Error.
 	Get(...*corev1.SecretKeySelector) (Secrets, error)
 }
 
@@ -71,14 +87,73 @@ func (g *GetterWithClientset) Get(refs ...*corev1.SecretKeySelector) (Secrets, e
 				secr = secretCache[ref.Name]
 			} else {
 				secr, err = g.cli.Get(context.Background(), ref.Name, metav1.GetOptions{})
-				if err != nil {
+				switch {
+				case apierrors.IsNotFound(err):
+					return nil, newError(ref, ErrSecretNotFound)
+				case err != nil:
 					return nil, fmt.Errorf("getting Secret %q from cluster: %w", ref.Name, err)
 				}
 
 				secretCache[ref.Name] = secr
 			}
 
-			val = string(secr.Data[ref.Key])
+			if val, err = keyValue(secr, ref); err != nil {
+				return nil, err
+			}
+		}
+
+		s = append(s, val)
+	}
+
+	return s, nil
+}
+
+// NewListerGetter returns a Getter which reads Secrets from the given
+// namespaced Secret lister, and falls back to the given namespaced Secret
+// client interface for Secrets which are missing from the lister's cache.
+func NewListerGetter(lister corelistersv1.SecretNamespaceLister,
+	cli coreclientv1.SecretInterface) *GetterWithLister {
+
+	return &GetterWithLister{
+		lister: lister,
+		cli:    cli,
+	}
+}
+
+// GetterWithLister gets Kubernetes secrets from the cache of an informer.
+// Since this cache is typically restricted to Secrets which carry the
+// WatchLabelKey label, Secrets which are missing from it are retrieved from
+// the Kubernetes API.
+type GetterWithLister struct {
+	lister corelistersv1.SecretNamespaceLister
+	cli    coreclientv1.SecretInterface
+}
+
+// GetterWithLister implements Getter.
+var _ Getter = (*GetterWithLister)(nil)
+
+// Get implements Getter.
+func (g *GetterWithLister) Get(refs ...*corev1.SecretKeySelector) (Secrets, error) {
+	s := make(Secrets, 0, len(refs))
+
+	for _, ref := range refs {
+		var val string
+
+		if ref != nil {
+			secr, err := g.lister.Get(ref.Name)
+			if apierrors.IsNotFound(err) {
+				secr, err = g.cli.Get(context.Background(), ref.Name, metav1.GetOptions{})
+			}
+			switch {
+			case apierrors.IsNotFound(err):
+				return nil, newError(ref, ErrSecretNotFound)
+			case err != nil:
+				return nil, fmt.Errorf("getting Secret %q from cluster: %w", ref.Name, err)
+			}
+
+			if val, err = keyValue(secr, ref); err != nil {
+				return nil, err
+			}
 		}
 
 		s = append(s, val)
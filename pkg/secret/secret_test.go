@@ -26,6 +26,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 )
 
 func TestGetter(t *testing.T) {
@@ -145,6 +147,92 @@ func TestGetter(t *testing.T) {
 	}
 }
 
+func TestListerGetter(t *testing.T) {
+	const ns = "fake-namespace"
+
+	cached := newSecret(ns, "cached", map[string]string{"key": "cached value"})
+	uncached := newSecret(ns, "uncached", map[string]string{"key": "uncached value"})
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(cached))
+
+	cli := fake.NewSimpleClientset(cached, uncached)
+
+	sg := NewListerGetter(corelistersv1.NewSecretLister(indexer).Secrets(ns), cli.CoreV1().Secrets(ns))
+
+	ref := func(name string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  "key",
+		}
+	}
+
+	output, err := sg.Get(ref("cached"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, Secrets{"cached value", ""}, output)
+	assert.Len(t, cli.Actions(), 0, "Unexpected API request for a cached Secret")
+
+	output, err = sg.Get(ref("uncached"))
+	require.NoError(t, err)
+	assert.Equal(t, Secrets{"uncached value"}, output)
+	assert.Len(t, cli.Actions(), 1, "Expected an API request for a Secret missing from the cache")
+
+	_, err = sg.Get(ref("missing"))
+	assert.ErrorIs(t, err, ErrSecretNotFound)
+}
+
+func TestGetterErrors(t *testing.T) {
+	const ns = "fake-namespace"
+
+	cli := fake.NewSimpleClientset(
+		newSecret(ns, "secret", map[string]string{
+			"key":   "value",
+			"empty": "",
+		}),
+	)
+
+	sg := NewGetter(cli.CoreV1().Secrets(ns))
+
+	testCases := map[string]struct {
+		ref     *corev1.SecretKeySelector
+		wantErr error
+	}{
+		"missing Secret": {
+			ref: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+				Key:                  "key",
+			},
+			wantErr: ErrSecretNotFound,
+		},
+		"missing key": {
+			ref: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+				Key:                  "typo",
+			},
+			wantErr: ErrKeyNotFound,
+		},
+		"empty value": {
+			ref: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+				Key:                  "empty",
+			},
+			wantErr: ErrEmptyValue,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, err := sg.Get(tc.ref)
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			var secretErr *Error
+			require.ErrorAs(t, err, &secretErr)
+			assert.Equal(t, tc.ref.Name, secretErr.Name)
+			assert.Equal(t, tc.ref.Key, secretErr.Key)
+		})
+	}
+}
+
 func newSecret(ns, name string, data map[string]string) *corev1.Secret {
 	secr := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{